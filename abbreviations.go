@@ -0,0 +1,60 @@
+package addrFmt
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// compiled patterns of abbreviated words (long form => *regexp.Regexp)
+var abbreviationPatterns sync.Map
+
+// applyAbbreviations shortens the components with the abbreviations of the letter's language or
+// of the languages spoken in the country
+func applyAbbreviations(addressMap addressMap, config *Config, outputLanguage string) {
+	for _, language := range getOutputLanguages(addressMap["country_code"], config, outputLanguage) {
+		abbreviation, hasAbbreviation := config.Abbreviations[language]
+		if !hasAbbreviation {
+			continue
+		}
+
+		for component, replacements := range abbreviation {
+			if value, hasComponent := addressMap[component]; hasComponent {
+				addressMap[component] = abbreviate(value, replacements)
+			}
+		}
+	}
+}
+
+// abbreviate replaces whole words, longer words first to not break up words containing a shorter one
+func abbreviate(value string, replacements map[string]string) string {
+	longForms := make([]string, 0, len(replacements))
+	for long := range replacements {
+		longForms = append(longForms, long)
+	}
+	sort.Slice(longForms, func(i, j int) bool {
+		if len(longForms[i]) != len(longForms[j]) {
+			return len(longForms[i]) > len(longForms[j])
+		}
+		return longForms[i] < longForms[j]
+	})
+
+	for _, long := range longForms {
+		short := strings.ReplaceAll(replacements[long], "$", "$$")
+		value = getAbbreviationPattern(long).ReplaceAllString(value, "${1}"+short+"${2}")
+	}
+
+	return value
+}
+
+func getAbbreviationPattern(long string) *regexp.Regexp {
+	if pattern, isCompiled := abbreviationPatterns.Load(long); isCompiled {
+		return pattern.(*regexp.Regexp)
+	}
+
+	pattern := regexp.MustCompile(`(^|[\s,(])` + regexp.QuoteMeta(long) + `($|[\s,.)])`)
+	abbreviationPatterns.Store(long, pattern)
+
+	return pattern
+}
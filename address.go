@@ -1,33 +1,33 @@
 package addrFmt
 
 type Address struct {
-	Attention     string
-	House         string
-	HouseNumber   string
-	Road          string
-	Hamlet        string
-	Village       string
-	Neighbourhood string
-	PostalCity    string
-	City          string
-	CityDistrict  string
-	Municipality  string
-	County        string
-	CountyCode    string
-	StateDistrict string
-	Postcode      string
-	State         string
-	StateCode     string
-	Region        string
-	Suburb        string
-	Quarter       string
-	Residential   string
-	Town          string
-	Island        string
-	Archipelago   string
-	Country       string
-	CountryCode   string
-	Continent     string
+	Attention     string `json:"attention,omitempty"`
+	House         string `json:"house,omitempty"`
+	HouseNumber   string `json:"house_number,omitempty"`
+	Road          string `json:"road,omitempty"`
+	Hamlet        string `json:"hamlet,omitempty"`
+	Village       string `json:"village,omitempty"`
+	Neighbourhood string `json:"neighbourhood,omitempty"`
+	PostalCity    string `json:"postal_city,omitempty"`
+	City          string `json:"city,omitempty"`
+	CityDistrict  string `json:"city_district,omitempty"`
+	Municipality  string `json:"municipality,omitempty"`
+	County        string `json:"county,omitempty"`
+	CountyCode    string `json:"county_code,omitempty"`
+	StateDistrict string `json:"state_district,omitempty"`
+	Postcode      string `json:"postcode,omitempty"`
+	State         string `json:"state,omitempty"`
+	StateCode     string `json:"state_code,omitempty"`
+	Region        string `json:"region,omitempty"`
+	Suburb        string `json:"suburb,omitempty"`
+	Quarter       string `json:"quarter,omitempty"`
+	Residential   string `json:"residential,omitempty"`
+	Town          string `json:"town,omitempty"`
+	Island        string `json:"island,omitempty"`
+	Archipelago   string `json:"archipelago,omitempty"`
+	Country       string `json:"country,omitempty"`
+	CountryCode   string `json:"country_code,omitempty"`
+	Continent     string `json:"continent,omitempty"`
 }
 
 type addressMap map[string]string
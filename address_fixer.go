@@ -1,8 +1,9 @@
 package addrFmt
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"github.com/timonmasberg/address-formatter/iso3166"
 	"log"
 	"regexp"
 	"strconv"
@@ -10,41 +11,74 @@ import (
 )
 
 // GetFixedAddress Fixes postcode/country, adds missing state/county/country-code and applies template replacements
-// GetFixedAddress Entrypoint for data such as from osm
-func GetFixedAddress(addressMap addressMap, config *Config) (*Address, error) {
+// GetFixedAddress Entrypoint for data such as from osm, WithLanguage translates state and country
+// GetFixedAddress works on a copy of addressMap and is safe for concurrent use
+func GetFixedAddress(addressMap addressMap, config *Config, opts ...Option) (*Address, error) {
+	return fixAddress(context.Background(), copyAddressMap(addressMap), config, newOptions(opts))
+}
+
+// GetFixedAddressContext works like GetFixedAddress but stops with the error of ctx once it is cancelled or its deadline is exceeded
+func GetFixedAddressContext(ctx context.Context, addressMap addressMap, config *Config, opts ...Option) (*Address, error) {
+	return fixAddress(ctx, copyAddressMap(addressMap), config, newOptions(opts))
+}
+
+// GetFixedAddressInPlace works like GetFixedAddress without copying addressMap, the components are fixed in the
+// given map which must not be used concurrently
+func GetFixedAddressInPlace(addressMap addressMap, config *Config, opts ...Option) (*Address, error) {
+	return fixAddress(context.Background(), addressMap, config, newOptions(opts))
+}
+
+func fixAddress(ctx context.Context, addressMap addressMap, config *Config, options *options) (*Address, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	applySubdivisionCode(addressMap)
 	addressMap["country_code"] = getFixedCountryCode(addressMap["country_code"])
+	if addressMap["country_code"] == "" && config.ResolveCountryNames {
+		addressMap["country_code"], _ = ResolveCountryCode(addressMap["country"], config)
+	}
 	// set template before applying aliases to ensure country template is being used
-	template := findTemplate(addressMap["country_code"], config.Templates)
+	template := findTemplate(addressMap["country_code"], config.getTemplates())
 
 	addressMap["country_code"] = determineCountryCode(addressMap["country_code"], template)
 
-	newCountry, hasChangeCountry := template.(map[string]interface{})["change_country"].(string)
-	if hasChangeCountry {
-		var err error
-		addressMap["country"], err = determineCountry(addressMap, newCountry)
+	templateValue, _ := template.(map[string]interface{})
 
-		if err != nil {
-			return nil, err
-		}
+	if newCountry, hasChangeCountry := templateValue["change_country"].(string); hasChangeCountry {
+		addressMap["country"] = determineCountry(addressMap, newCountry)
 	}
 
-	addComponent, hasAddComponent := template.(map[string]interface{})["add_component"].(string)
-	if hasAddComponent {
+	if addComponent, hasAddComponent := templateValue["add_component"].(string); hasAddComponent {
 		addTemplateComponents(addressMap, addComponent)
 	}
 
 	applySpecialCases(addressMap)
 
-	replacements, hasReplacements := template.(map[string]interface{})["replace"].([]interface{})
-	if hasReplacements {
-		applyReplacements(addressMap, replacements)
+	if replacements, hasReplacements := templateValue["replace"].([]interface{}); hasReplacements {
+		if err := applyReplacements(addressMap, replacements, config.cache); err != nil {
+			return nil, fmt.Errorf("invalid replace: %w", err)
+		}
 	}
 
 	applyUrlCleanup(addressMap)
 
-	address := MapToAddress(addressMap, config.ComponentAliases, config.UnknownAsAttention)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	address := mapToAddress(addressMap, config.ComponentAliases, config.UnknownAsAttention)
 	cleanupAddress(address, config)
 
+	if options.language != "" {
+		if address.State != "" {
+			address.State = getLocalizedState(address.State, address.StateCode, address.CountryCode, options.language, config.StateCodes)
+		}
+		if address.Country != "" {
+			address.Country = getLocalizedCountry(address.Country, address.CountryCode, options.language)
+		}
+	}
+
 	return address, nil
 }
 
@@ -75,6 +109,12 @@ func cleanupAddress(address *Address, config *Config) {
 		address.CountyCode = getCountyCode(address.County, address.CountryCode, config.CountyCodes)
 	}
 
+	if address.Continent == "" && config.AddContinent {
+		if region, hasRegion := GetCountryRegion(address.CountryCode); hasRegion {
+			address.Continent = region.Continent
+		}
+	}
+
 	if address.Postcode != "" {
 		if len(address.Postcode) > 20 || multiplePostcodeCheck.MatchString(address.Postcode) {
 			address.Postcode = ""
@@ -86,38 +126,41 @@ func cleanupAddress(address *Address, config *Config) {
 	}
 }
 
-func applyReplacements(address addressMap, replacements []interface{}) {
+func applyReplacements(address addressMap, replacements []interface{}, cache *configCache) error {
 	for key, value := range address {
-		for _, replacement := range replacements {
-			r, err := regexp.Compile("^" + key + "=")
-
+		for i, replacement := range replacements {
+			replacementSrc, replacementVal, err := getReplacementRule(replacement)
 			if err != nil {
-				log.Printf("Could not replace due to bad regexp: %v", err)
+				return fmt.Errorf("replacement %d: %w", i, err)
 			}
 
-			replacementSrc := replacement.([]interface{})[0].(string)
-			replacementVal := replacement.([]interface{})[1].(string)
-
-			if r.MatchString(replacementSrc) {
-				valueAfterReplacement := r.ReplaceAllString(replacementSrc, "")
-
-				if value == valueAfterReplacement {
+			// rules such as "city=Berlin" replace the whole value of a component
+			if strings.HasPrefix(replacementSrc, key+"=") {
+				if value == strings.TrimPrefix(replacementSrc, key+"=") {
 					address[key] = replacementVal
 				}
 			} else {
-				r, err = regexp.Compile(replacementSrc)
+				r, err := cache.compilePattern(replacementSrc)
 
 				if err != nil {
 					log.Printf("Could not replace due to bad regexp: %v", err)
+					continue
 				}
 
 				address[key] = r.ReplaceAllString(address[key], replacementVal)
 			}
 		}
 	}
+
+	return nil
 }
 
 func getFixedCountryCode(countryCode string) string {
+	// alpha-3 and numeric codes
+	if alpha2, isIsoCode := iso3166.ToAlpha2(countryCode); isIsoCode {
+		return alpha2
+	}
+
 	if len(countryCode) != 2 {
 		return ""
 	}
@@ -136,7 +179,8 @@ func determineCountryCode(countryCode string, template template) string {
 		return ""
 	}
 
-	newCountryCode, hasUseCountry := template.(map[string]interface{})["use_country"].(string)
+	templateValue, _ := template.(map[string]interface{})
+	newCountryCode, hasUseCountry := templateValue["use_country"].(string)
 
 	if hasUseCountry {
 		countryCode = strings.ToUpper(newCountryCode)
@@ -164,27 +208,16 @@ func addTemplateComponents(addressMap addressMap, addComponent string) {
 
 var countryCheck = regexp.MustCompile(`\$(\w*)`)
 
-func determineCountry(addressMap addressMap, newCountry string) (string, error) {
+func determineCountry(addressMap addressMap, newCountry string) string {
 	matches := countryCheck.FindStringSubmatch(newCountry)
 
 	if matches != nil {
 		component := matches[1]
-		componentVal, hasComponent := addressMap[component]
-
-		r, err := regexp.Compile(`\$` + component)
-
-		if err != nil {
-			return "", errors.New(fmt.Sprintf("Could not compile regex for component %s: %v", componentVal, err))
-		}
-
-		if hasComponent {
-			newCountry = r.ReplaceAllString(newCountry, componentVal)
-		} else {
-			newCountry = r.ReplaceAllString(newCountry, "")
-		}
+		// a missing component is replaced with an empty string
+		newCountry = strings.ReplaceAll(newCountry, "$"+component, addressMap[component])
 	}
 
-	return newCountry, nil
+	return newCountry
 }
 
 var sintMaartenCheck = regexp.MustCompile("(?i)sint maarten")
@@ -227,7 +260,8 @@ func getCountyCode(county string, countryCode string, countyCodes map[string]map
 				}
 			} else if variants, hasVariants := v.(map[string]interface{}); hasVariants {
 				for _, countyVariant := range variants {
-					if strings.ToUpper(countyVariant.(string)) == county {
+					countyOfVariant, isString := countyVariant.(string)
+					if isString && strings.ToUpper(countyOfVariant) == county {
 						return code
 					}
 				}
@@ -249,7 +283,8 @@ func getStateCode(state string, countryCode string, stateCodes map[string]map[st
 				}
 			} else if variants, hasVariants := v.(map[string]interface{}); hasVariants {
 				for _, stateVariant := range variants {
-					if strings.ToUpper(stateVariant.(string)) == state {
+					stateOfVariant, isString := stateVariant.(string)
+					if isString && strings.ToUpper(stateOfVariant) == state {
 						return code
 					}
 				}
@@ -260,6 +295,19 @@ func getStateCode(state string, countryCode string, stateCodes map[string]map[st
 	return ""
 }
 
+// splits ISO 3166-2 state codes such as US-CA into the country and state code, a provided country code is kept
+func applySubdivisionCode(addressMap addressMap) {
+	countryCode, stateCode, isSubdivision := iso3166.ParseSubdivision(addressMap["state_code"])
+
+	if isSubdivision {
+		addressMap["state_code"] = stateCode
+
+		if addressMap["country_code"] == "" {
+			addressMap["country_code"] = countryCode
+		}
+	}
+}
+
 var urlCheck = regexp.MustCompile(`^https?://`)
 
 func applyUrlCleanup(addressMap addressMap) {
@@ -0,0 +1,65 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestFixerTestSuite(t *testing.T) {
+	suite.Run(t, new(FixerTestSuite))
+}
+
+type FixerTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *FixerTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+}
+
+func getRawAddressMap() map[string]string {
+	return map[string]string{
+		"street":        "Platz der Republik",
+		"street_number": "1",
+		"postcode":      "11011",
+		"city":          "Berlin",
+		"state":         "Berlin",
+		"country_code":  "DEU",
+		"website":       "https://www.bundestag.de",
+	}
+}
+
+func (suite *FixerTestSuite) TestGetFixedAddressDoesNotModifyInput() {
+	addressMap := getRawAddressMap()
+
+	address, err := GetFixedAddress(addressMap, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("DE", address.CountryCode)
+	suite.Equal("Platz der Republik", address.Road)
+	suite.Equal("BE", address.StateCode)
+	suite.Equal(getRawAddressMap(), addressMap)
+}
+
+func (suite *FixerTestSuite) TestGetFixedAddressInPlace() {
+	addressMap := getRawAddressMap()
+
+	address, err := GetFixedAddressInPlace(addressMap, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("DE", address.CountryCode)
+	suite.Equal("DE", addressMap["country_code"])
+	suite.Equal("Platz der Republik", addressMap["road"])
+	suite.NotContains(addressMap, "website")
+}
+
+func (suite *FixerTestSuite) TestMapToAddressDoesNotModifyInput() {
+	addressMap := getRawAddressMap()
+
+	address := MapToAddress(addressMap, suite.Config.ComponentAliases, false)
+
+	suite.Equal("Platz der Republik", address.Road)
+	suite.Equal("1", address.HouseNumber)
+	suite.Equal(getRawAddressMap(), addressMap)
+}
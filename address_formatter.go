@@ -1,12 +1,15 @@
 package addrFmt
 
 import (
+	"bytes"
+	"context"
 	"errors"
-	"github.com/cbroglie/mustache"
+	"fmt"
 	"html"
 	"log"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 var commonCountryCodeAliases = map[string]string{"UK": "GB"}
@@ -34,7 +37,20 @@ var replacements = []replacement{
 }
 
 // FormatAddress formats an Address object based on it
-func FormatAddress(address *Address, config *Config) (interface{}, error) {
+func FormatAddress(address *Address, config *Config, opts ...Option) (interface{}, error) {
+	return formatAddress(context.Background(), address, config, newOptions(opts))
+}
+
+// FormatAddressContext works like FormatAddress but stops with the error of ctx once it is cancelled or its deadline is exceeded
+func FormatAddressContext(ctx context.Context, address *Address, config *Config, opts ...Option) (interface{}, error) {
+	return formatAddress(ctx, address, config, newOptions(opts))
+}
+
+func formatAddress(ctx context.Context, address *Address, config *Config, options *options) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// ease up the Address into a map to make it accessible via index
 	addressMap, err := addressToMap(address)
 
@@ -42,8 +58,7 @@ func FormatAddress(address *Address, config *Config) (interface{}, error) {
 		return nil, err
 	}
 
-	template := findTemplate(address.CountryCode, config.Templates)
-	render, err := applyTemplate(addressMap, template, config.Templates)
+	render, err := renderAddressMap(ctx, addressMap, config, options)
 	if err != nil {
 		return nil, err
 	}
@@ -51,20 +66,134 @@ func FormatAddress(address *Address, config *Config) (interface{}, error) {
 	return getOutput(render, config.OutputFormat)
 }
 
-func applyTemplate(addressMap addressMap, template template, templates map[string]template) (string, error) {
-	templateText := chooseTemplateText(addressMap, template, templates)
+// renderAddressMap renders the address components with the template of their country into lines separated by \n
+func renderAddressMap(ctx context.Context, addressMap addressMap, config *Config, options *options) (string, error) {
+	applySubdivisionCode(addressMap)
+	if countryCode := getFixedCountryCode(addressMap["country_code"]); countryCode != "" {
+		addressMap["country_code"] = countryCode
+	}
+
+	localizeComponents(addressMap, config, options.language)
+	if config.Abbreviate {
+		applyAbbreviations(addressMap, config, options.language)
+	}
+	if err := transliterateComponents(addressMap, options.transliteration); err != nil {
+		return "", err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	isDomestic := isHomeCountry(addressMap["country_code"], options.homeCountry, config.HomeCountry)
+	country := addressMap["country"]
+	if isDomestic || options.countryLine != "" {
+		delete(addressMap, "country")
+	}
+
+	templates := config.getTemplates()
+	template := findAddressTemplate(addressMap, options.script, templates)
+	render, err := applyTemplate(ctx, addressMap, template, templates)
+	if err != nil {
+		return "", err
+	}
+
+	if isDomestic {
+		render = removeCountryArtefacts(render, addressMap, country)
+	}
+	hasCountryLine := addressMap["country"] != ""
+	if options.countryLine != "" {
+		render, hasCountryLine = appendCountryLine(render, options.countryLine)
+	}
 
-	render, _ := mustache.Render(templateText, getRenderInput(addressMap))
+	language := options.language
+	if language == "" {
+		language = getLanguage(addressMap["country_code"], config)
+	}
+
+	render = applyUppercase(render, options.uppercase, hasCountryLine, language)
+
+	// the text of the template, the country line and uppercase letters such as the turkish İ are transliterated as well
+	return transliterate(render, options.transliteration)
+}
+
+// appendCountryLine appends the country line as last line of the cleaned up render. A last line that equals the
+// country line, e.g. a locality named like the country, is not repeated and is no separate country line then
+func appendCountryLine(render string, countryLine string) (string, bool) {
+	lastLine := render[strings.LastIndex(render, "\n")+1:]
+	if strings.EqualFold(lastLine, countryLine) {
+		return render, false
+	}
+	if render == "" {
+		return countryLine, true
+	}
+
+	return render + "\n" + countryLine, true
+}
+
+// the home country of the call takes precedence over the configured one
+func isHomeCountry(countryCode string, homeCountry string, defaultHomeCountry string) bool {
+	if homeCountry == "" {
+		homeCountry = defaultHomeCountry
+	}
+
+	return countryCode != "" && getFixedCountryCode(homeCountry) == countryCode
+}
+
+// removeCountryArtefacts removes a trailing country line that was added by postformat replacements
+// although the country component has been omitted, a line equal to another component is kept unless it
+// is repeated (e.g. city states)
+func removeCountryArtefacts(render string, addressMap addressMap, country string) string {
+	lastLineIndex := strings.LastIndex(render, "\n")
+	lastLine := render[lastLineIndex+1:]
+	isRepeated := lastLineIndex > 0 && strings.Contains(strings.ToLower(render[:lastLineIndex]), strings.ToLower(lastLine))
+
+	for _, value := range addressMap {
+		if strings.EqualFold(value, lastLine) && !isRepeated {
+			return render
+		}
+	}
+
+	isCountryName := strings.EqualFold(lastLine, country)
+	for _, name := range countryNames[addressMap["country_code"]] {
+		isCountryName = isCountryName || strings.EqualFold(lastLine, name)
+	}
+
+	if !isCountryName {
+		return render
+	}
+	if lastLineIndex < 0 {
+		return ""
+	}
+
+	return render[:lastLineIndex]
+}
+
+func applyTemplate(ctx context.Context, addressMap addressMap, template template, templates templateSet) (string, error) {
+	templateText, err := chooseTemplateText(addressMap, template, templates)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	render, err := renderTemplateText(templateText, getRenderInput(addressMap), templates.cache)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
 	// unescape render to enforce official mustache HTML escaping rules
 	render = html.UnescapeString(render)
 	// todo: postformat replacements rely on a clean render but can mess it up again... (constraint by OpenCageData)
-	var err error
-	render, err = cleanupRender(render)
+	render, err = cleanupRender(ctx, render)
 	if err != nil {
 		return "", err
 	}
-	render = applyPostformatReplacements(render, template)
-	render, err = cleanupRender(render)
+	if err = ctx.Err(); err != nil {
+		return "", err
+	}
+	render, err = applyPostformatReplacements(render, template, templates.cache)
+	if err != nil {
+		return "", fmt.Errorf("invalid postformat_replace: %w", err)
+	}
+	render, err = cleanupRender(ctx, render)
 	if err != nil {
 		return "", err
 	}
@@ -72,6 +201,15 @@ func applyTemplate(addressMap addressMap, template template, templates map[strin
 	return render, nil
 }
 
+func renderTemplateText(templateText string, input map[string]interface{}, cache *configCache) (string, error) {
+	parsedTemplate, err := cache.parseTemplate(templateText)
+	if err != nil {
+		return "", err
+	}
+
+	return parsedTemplate.Render(input)
+}
+
 var possibilitiesRegExp = regexp.MustCompile(`\s*\|\|\s*`)
 
 func getRenderInput(addressMap addressMap) map[string]interface{} {
@@ -97,84 +235,183 @@ func getRenderInput(addressMap addressMap) map[string]interface{} {
 	return input
 }
 
-func chooseTemplateText(address addressMap, template template, templates map[string]template) string {
-	var templateText string
-
+func chooseTemplateText(address addressMap, template template, templates templateSet) (string, error) {
 	templateValue, isTemplateMap := template.(map[string]interface{})
+	if !isTemplateMap {
+		return getTemplateText(template, "address_template")
+	}
 
-	if isTemplateMap {
-		missingPropertyCount := 0
-		for _, requiredProperty := range requiredAddressProperties {
-			if _, hasProperty := address[requiredProperty]; !hasProperty {
-				missingPropertyCount++
-			}
-		}
+	key := "address_template"
+	if usesFallbackTemplate(address) {
+		key = "fallback_template"
+	}
 
-		if missingPropertyCount == len(requiredAddressProperties) {
-			if fallbackTemplate, hasFallbackTemplate := templateValue["fallback_template"]; hasFallbackTemplate {
-				templateText = fallbackTemplate.(string)
-			} else if defaultTemplate, hasDefaultTemplate := templates["default"]; hasDefaultTemplate {
-				templateText = defaultTemplate.(map[string]interface{})["fallback_template"].(string)
-			}
-		} else // has country specific template
-		if addressTemplate, hasAddressTemplate := templateValue["address_template"]; hasAddressTemplate {
-			templateText = addressTemplate.(string)
-		} else // has default template
-		if defaultTemplate, hasDefaultTemplate := templates["default"]; hasDefaultTemplate {
-			templateText = defaultTemplate.(map[string]interface{})["address_template"].(string)
+	return findTemplateText(templateValue, key, templates)
+}
+
+// findTemplateText returns the text of the template or of the default template for key
+func findTemplateText(templateValue map[string]interface{}, key string, templates templateSet) (string, error) {
+	// has country specific template
+	if templateText, hasTemplateText := templateValue[key]; hasTemplateText {
+		return getTemplateText(templateText, key)
+	}
+
+	// has default template
+	defaultTemplate, _ := templates.get("default")
+	defaultTemplateValue, _ := defaultTemplate.(map[string]interface{})
+
+	return getTemplateText(defaultTemplateValue[key], key)
+}
+
+// usesFallbackTemplate reports whether all requiredAddressProperties are missing
+func usesFallbackTemplate(address addressMap) bool {
+	for _, requiredProperty := range requiredAddressProperties {
+		if _, hasProperty := address[requiredProperty]; hasProperty {
+			return false
 		}
-	} else {
-		templateText = template.(string)
 	}
 
-	return templateText
+	return true
 }
 
-func cleanupRender(render string) (string, error) {
+func getTemplateText(templateText interface{}, key string) (string, error) {
+	switch templateValue := templateText.(type) {
+	case string:
+		return templateValue, nil
+	case nil:
+		return "", fmt.Errorf("%s is missing", key)
+	default:
+		return "", fmt.Errorf("%s of type %T is not a string", key, templateText)
+	}
+}
+
+// cleanupRender applies the replacements and deduplicates lines and their parts after each of them
+func cleanupRender(ctx context.Context, render string) (string, error) {
+	buffer := renderBufferPool.Get().(*renderBuffer)
+	defer renderBufferPool.Put(buffer)
+
+	// deduplicating a render that has not changed since its last deduplication returns the same render
+	isDeduped := false
 	for _, replacement := range replacements {
-		render = replacement.pattern.ReplaceAllString(render, replacement.replace)
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
 
-		render = dedupe(strings.Split(render, "\n"), "\n", func(s string) string {
-			return dedupe(strings.Split(s, ", "), ", ", func(s string) string {
-				return s
-			})
-		})
+		replaced := render
+		if replacement.pattern.MatchString(render) {
+			replaced = replacement.pattern.ReplaceAllString(render, replacement.replace)
+		}
+		if isDeduped && replaced == render {
+			continue
+		}
+
+		render = dedupeRender(replaced, buffer)
+		isDeduped = render == replaced
 	}
 
 	return strings.TrimSpace(render), nil
 }
 
-func dedupe(chunks []string, glue string, modifier func(s string) string) string {
-	seen := make(map[string]bool)
-	result := make([]string, 0)
+// renderBuffer is reused by dedupeRender to not allocate a builder and sets for every render
+type renderBuffer struct {
+	builder   bytes.Buffer
+	seenLines map[string]struct{}
+	seenParts map[string]struct{}
+}
+
+var renderBufferPool = sync.Pool{
+	New: func() interface{} {
+		return &renderBuffer{seenLines: make(map[string]struct{}), seenParts: make(map[string]struct{})}
+	},
+}
+
+// dedupeRender trims the lines and their comma separated parts and removes repeated ones in one pass.
+// Lines are compared before their parts are deduplicated and "new york" is never removed (New York, New York)
+func dedupeRender(render string, buffer *renderBuffer) string {
+	buffer.builder.Reset()
+	clearSet(buffer.seenLines)
+
+	isFirstLine := true
+	for rest, hasNext := render, true; hasNext; {
+		var line string
+		line, rest, hasNext = strings.Cut(rest, "\n")
+		line = strings.TrimSpace(line)
+
+		isKept := isNewYork(line)
+		if _, isSeen := buffer.seenLines[line]; isSeen && !isKept {
+			continue
+		}
+		buffer.seenLines[line] = struct{}{}
+
+		if !isFirstLine {
+			buffer.builder.WriteByte('\n')
+		}
+		isFirstLine = false
 
-	for _, chunk := range chunks {
-		chunk = strings.TrimSpace(chunk)
-		if strings.ToLower(chunk) == "new york" {
-			seen[chunk] = true
-			result = append(result, chunk)
-		} else if seenChunk, hasChunk := seen[chunk]; !hasChunk || !seenChunk {
-			seen[chunk] = true
-			result = append(result, modifier(chunk))
+		if isKept {
+			buffer.builder.WriteString(line)
+		} else {
+			writeDedupedParts(line, buffer)
 		}
 	}
 
-	return strings.Join(result, glue)
+	return buffer.builder.String()
 }
 
-func applyPostformatReplacements(render string, template template) string {
-	if postformatReplacements, hasReplacements := template.(map[string]interface{})["postformat_replace"].([]interface{}); hasReplacements {
-		for _, replacement := range postformatReplacements {
-			r, err := regexp.Compile(replacement.([]interface{})[0].(string))
+func writeDedupedParts(line string, buffer *renderBuffer) {
+	clearSet(buffer.seenParts)
+
+	isFirstPart := true
+	for rest, hasNext := line, true; hasNext; {
+		var part string
+		part, rest, hasNext = strings.Cut(rest, ", ")
+		part = strings.TrimSpace(part)
+
+		if _, isSeen := buffer.seenParts[part]; isSeen && !isNewYork(part) {
+			continue
+		}
+		buffer.seenParts[part] = struct{}{}
+
+		if !isFirstPart {
+			buffer.builder.WriteString(", ")
+		}
+		isFirstPart = false
+		buffer.builder.WriteString(part)
+	}
+}
+
+// the length check avoids lowering long chunks, runes lowered to ascii letters take at most 3 bytes
+func isNewYork(chunk string) bool {
+	return len(chunk) <= 3*len("new york") && strings.ToLower(chunk) == "new york"
+}
+
+func clearSet(set map[string]struct{}) {
+	for key := range set {
+		delete(set, key)
+	}
+}
+
+func applyPostformatReplacements(render string, template template, cache *configCache) (string, error) {
+	templateValue, _ := template.(map[string]interface{})
+
+	if postformatReplacements, hasReplacements := templateValue["postformat_replace"].([]interface{}); hasReplacements {
+		for i, replacement := range postformatReplacements {
+			pattern, replace, err := getReplacementRule(replacement)
+			if err != nil {
+				return "", fmt.Errorf("replacement %d: %w", i, err)
+			}
+
+			r, err := cache.compilePattern(pattern)
 			if err != nil {
 				log.Printf("Could not replace due to bad regexp: %v", err)
+				continue
 			}
 
-			render = r.ReplaceAllString(render, replacement.([]interface{})[1].(string))
+			render = r.ReplaceAllString(render, replace)
 		}
 	}
 
-	return render
+	return render, nil
 }
 
 func getOutput(render string, outputFormat OutputFormat) (interface{}, error) {
@@ -2,10 +2,7 @@ package addrFmt
 
 import (
 	"github.com/stretchr/testify/suite"
-	"gopkg.in/yaml.v3"
-	"io/ioutil"
-	"path/filepath"
-	"strings"
+	"github.com/timonmasberg/address-formatter/internal/testcase"
 	"testing"
 )
 
@@ -40,35 +37,17 @@ func (suite *FormatterTestSuite) SetupTest() {
 	suite.Config.UnknownAsAttention = true
 
 	// Load TestCases
-	testFiles, _ := filepath.Glob("testcases/*.yaml")
+	testCases, err := testcase.LoadDir("testcases")
+	suite.Require().NoError(err)
 
-	for _, filePath := range testFiles {
-		fileContent, _ := ioutil.ReadFile(filePath)
-		fileContentStr := string(fileContent)
+	for _, c := range testCases {
+		testCase := new(TestCase)
 
-		var testCaseParts []string
-		if strings.Count(fileContentStr, "---") > 1 {
-			testCaseParts = strings.Split(fileContentStr, "---")[1:]
-		} else {
-			testCaseParts = append(testCaseParts, fileContentStr)
-		}
-		for _, testCasePart := range testCaseParts {
-			var parsedFileContent struct {
-				Components map[string]string `yaml:"components"`
-				Expected   string            `yaml:"expected"`
-			}
-
-			yaml.Unmarshal([]byte(testCasePart), &parsedFileContent)
-
-			testCase := new(TestCase)
-
-			testCase.ExpectedOutput = parsedFileContent.Expected
-			testCase.Name = filepath.Base(filePath)
-			var err error
-			testCase.Address, err = GetFixedAddress(parsedFileContent.Components, suite.Config) // OpenCageData's expected output relies on fixed addresses
-			suite.NoError(err, "GetFixedAddress failed for test case %s", testCase.Name)
-			suite.TestCases = append(suite.TestCases, testCase)
-		}
+		testCase.ExpectedOutput = c.Expected
+		testCase.Name = c.Name()
+		testCase.Address, err = GetFixedAddress(c.Components, suite.Config) // OpenCageData's expected output relies on fixed addresses
+		suite.NoError(err, "GetFixedAddress failed for test case %s", testCase.Name)
+		suite.TestCases = append(suite.TestCases, testCase)
 	}
 }
 
@@ -0,0 +1,158 @@
+package addrFmt
+
+import (
+	"fmt"
+	"github.com/cbroglie/mustache"
+	"strings"
+)
+
+// Assessment reports which components the template of the destination country uses and which of them are missing
+type Assessment struct {
+	CountryCode string
+	// Requirements are the components of the template in template order
+	Requirements []Requirement
+	// Missing holds the first component of every requirement without a value, e.g. state_code for a US address
+	// without a state code and state
+	Missing []string
+	// UsesFallbackTemplate is set if road and postcode are missing and the address is rendered with the
+	// fallback_template
+	UsesFallbackTemplate bool
+	// Score is the share of requirements with a value from 0 to 1
+	Score float64
+}
+
+// Requirement is a component of the template or the alternatives of a {{#first}} section, of which the first one
+// with a value is rendered
+type Requirement struct {
+	Components []string
+	// Component is the rendered component or empty if all components are missing
+	Component string
+}
+
+// IsMissing reports whether none of the components has a value
+func (r Requirement) IsMissing() bool {
+	return r.Component == ""
+}
+
+// components that do not describe the location or only few addresses have are not assessed, the country is given by
+// the country code
+var unassessedComponents = map[string]bool{"attention": true, "house": true, "archipelago": true, "country": true}
+
+// Assess reports the completeness of an Address for the template it would be formatted with, e.g. to ask for the
+// missing components before shipping. The address should be fixed by GetFixedAddress first
+func Assess(address *Address, config *Config, opts ...Option) (*Assessment, error) {
+	addressMap, err := addressToMap(address)
+	if err != nil {
+		return nil, err
+	}
+
+	options := newOptions(opts)
+	applySubdivisionCode(addressMap)
+	if countryCode := getFixedCountryCode(addressMap["country_code"]); countryCode != "" {
+		addressMap["country_code"] = countryCode
+	}
+
+	templates := config.getTemplates()
+	template := findAddressTemplate(addressMap, options.script, templates)
+	templateText, err := chooseTemplateText(addressMap, template, templates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	parsedTemplate, err := templates.cache.parseTemplate(templateText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	assessment := &Assessment{
+		CountryCode:          addressMap["country_code"],
+		Requirements:         getRequirements(parsedTemplate.Tags(), addressMap),
+		UsesFallbackTemplate: usesFallbackTemplate(addressMap),
+		Score:                1,
+	}
+
+	for _, requirement := range assessment.Requirements {
+		if requirement.IsMissing() {
+			assessment.Missing = append(assessment.Missing, requirement.Components[0])
+		}
+	}
+	if len(assessment.Requirements) > 0 {
+		assessment.Score = 1 - float64(len(assessment.Missing))/float64(len(assessment.Requirements))
+	}
+
+	return assessment, nil
+}
+
+// UsesFallbackTemplate reports whether the address is formatted with the fallback_template as it has neither road
+// nor postcode, the check of Assess without its template lookup
+func UsesFallbackTemplate(address *Address) bool {
+	addressMap, err := addressToMap(address)
+
+	return err == nil && usesFallbackTemplate(addressMap)
+}
+
+// getRequirements collects the variables of the tags, the variables of a first section are one requirement.
+// Repeated requirements are only collected once
+func getRequirements(tags []mustache.Tag, addressMap addressMap) []Requirement {
+	var requirements []Requirement
+	seen := make(map[string]bool)
+
+	var collect func(tags []mustache.Tag)
+	collect = func(tags []mustache.Tag) {
+		for _, tag := range tags {
+			var components []string
+			switch {
+			case tag.Type() == mustache.Variable:
+				components = []string{tag.Name()}
+			case tag.Type() == mustache.Section && tag.Name() == "first":
+				components = getVariableNames(tag.Tags())
+			case tag.Type() == mustache.Section || tag.Type() == mustache.InvertedSection:
+				collect(tag.Tags())
+			}
+
+			components = removeUnassessedComponents(components)
+			key := strings.Join(components, "||")
+			if len(components) == 0 || seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			requirement := Requirement{Components: components}
+			for _, component := range components {
+				if addressMap[component] != "" {
+					requirement.Component = component
+					break
+				}
+			}
+			requirements = append(requirements, requirement)
+		}
+	}
+	collect(tags)
+
+	return requirements
+}
+
+func getVariableNames(tags []mustache.Tag) []string {
+	var names []string
+	for _, tag := range tags {
+		switch tag.Type() {
+		case mustache.Variable:
+			names = append(names, tag.Name())
+		case mustache.Section, mustache.InvertedSection:
+			names = append(names, getVariableNames(tag.Tags())...)
+		}
+	}
+
+	return names
+}
+
+func removeUnassessedComponents(components []string) []string {
+	assessedComponents := components[:0]
+	for _, component := range components {
+		if !unassessedComponents[component] {
+			assessedComponents = append(assessedComponents, component)
+		}
+	}
+
+	return assessedComponents
+}
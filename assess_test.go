@@ -0,0 +1,96 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestAssessTestSuite(t *testing.T) {
+	suite.Run(t, new(AssessTestSuite))
+}
+
+type AssessTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *AssessTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+}
+
+func (suite *AssessTestSuite) assess(address *Address, opts ...Option) *Assessment {
+	assessment, err := Assess(address, suite.Config, opts...)
+	suite.Require().NoError(err)
+
+	return assessment
+}
+
+func (suite *AssessTestSuite) TestComplete() {
+	assessment := suite.assess(&Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", Country: "Deutschland", CountryCode: "de"})
+
+	suite.Equal("DE", assessment.CountryCode)
+	suite.Empty(assessment.Missing)
+	suite.False(assessment.UsesFallbackTemplate)
+	suite.Equal(1.0, assessment.Score)
+	suite.Equal([]Requirement{
+		{Components: []string{"road"}, Component: "road"},
+		{Components: []string{"house_number"}, Component: "house_number"},
+		{Components: []string{"postcode"}, Component: "postcode"},
+		{Components: []string{"postal_city", "town", "city", "village", "municipality", "hamlet", "county", "state"}, Component: "city"},
+	}, assessment.Requirements)
+}
+
+func (suite *AssessTestSuite) TestMissingStateCode() {
+	address := &Address{HouseNumber: "1600", Road: "Pennsylvania Avenue NW", City: "Washington", Postcode: "20500", CountryCode: "US"}
+
+	assessment := suite.assess(address)
+	suite.Equal([]string{"state_code"}, assessment.Missing)
+	suite.Equal(0.8, assessment.Score)
+	suite.True(assessment.Requirements[3].IsMissing())
+	suite.Equal([]string{"state_code", "state"}, assessment.Requirements[3].Components)
+
+	// the state is rendered instead
+	address.State = "District of Columbia"
+	suite.Empty(suite.assess(address).Missing)
+}
+
+func (suite *AssessTestSuite) TestMissingPostcode() {
+	assessment := suite.assess(&Address{HouseNumber: "10", Road: "Downing Street", City: "London", CountryCode: "GB"})
+
+	suite.Equal([]string{"postcode"}, assessment.Missing)
+	suite.Equal(0.75, assessment.Score)
+}
+
+func (suite *AssessTestSuite) TestMissingHouseNumber() {
+	assessment := suite.assess(&Address{Road: "Carrera de San Jerónimo", Postcode: "28014", City: "Madrid", CountryCode: "ES"})
+
+	suite.Equal([]string{"house_number"}, assessment.Missing)
+	suite.False(assessment.UsesFallbackTemplate)
+}
+
+func (suite *AssessTestSuite) TestFallbackTemplate() {
+	assessment := suite.assess(&Address{City: "Berlin", Suburb: "Mitte", CountryCode: "DE"})
+
+	suite.True(assessment.UsesFallbackTemplate)
+	suite.Equal([]string{"road", "house_number", "place", "county", "state"}, assessment.Missing)
+	suite.Equal(2.0/7.0, assessment.Score)
+
+	suite.True(UsesFallbackTemplate(&Address{City: "Berlin", Suburb: "Mitte", CountryCode: "DE"}))
+	suite.False(UsesFallbackTemplate(&Address{Postcode: "11011", City: "Berlin", CountryCode: "DE"}))
+	suite.False(UsesFallbackTemplate(nil))
+}
+
+func (suite *AssessTestSuite) TestScriptTemplate() {
+	assessment := suite.assess(&Address{Road: "长安街", HouseNumber: "1", City: "北京市", CountryCode: "CN"}, WithScript(ScriptAuto))
+
+	suite.Equal([]string{"postcode", "state", "city_district", "suburb"}, assessment.Missing)
+	suite.Equal("CN", assessment.CountryCode)
+}
+
+func (suite *AssessTestSuite) TestInvalidAddress() {
+	_, err := Assess(nil, suite.Config)
+	suite.ErrorIs(err, ErrNilAddress)
+
+	_, err = Assess(&Address{Road: "Platz der Republik"}, &Config{})
+	suite.Error(err)
+}
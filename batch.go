@@ -0,0 +1,143 @@
+package addrFmt
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+var ErrNilAddress = errors.New("address is nil")
+
+// Result is the formatted address or the error of a single address of a batch or stream
+type Result struct {
+	// Index is the position of the address in the batch or stream
+	Index     int
+	Formatted interface{}
+	Err       error
+}
+
+// WithWorkers sets the number of goroutines FormatBatch and FormatStream format with, defaults to GOMAXPROCS
+func WithWorkers(workers int) Option {
+	return func(o *options) {
+		o.workers = workers
+	}
+}
+
+type formatJob struct {
+	index   int
+	address *Address
+	result  chan<- Result
+}
+
+// FormatBatch formats the addresses concurrently, the results have the order of the addresses and contain the
+// error of each address. If ctx is cancelled, the remaining addresses are skipped with the error of the context,
+// which is returned as well
+func FormatBatch(ctx context.Context, addresses []*Address, config *Config, opts ...Option) ([]Result, error) {
+	options := newOptions(opts)
+	results := make([]Result, len(addresses))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < options.getWorkers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				results[index] = formatResult(ctx, index, addresses[index], config, options)
+			}
+		}()
+	}
+
+	for index := range addresses {
+		indices <- index
+	}
+	close(indices)
+	wg.Wait()
+
+	return results, ctx.Err()
+}
+
+// FormatStream formats the addresses of the channel concurrently and sends the results in the order the addresses
+// were received. The returned channel is closed after the addresses channel has been closed and all results were sent
+// or once ctx is cancelled
+func FormatStream(ctx context.Context, addresses <-chan *Address, config *Config, opts ...Option) <-chan Result {
+	options := newOptions(opts)
+	workers := options.getWorkers()
+
+	jobs := make(chan formatJob)
+	// result channels in the order of the addresses, limits the addresses in flight
+	pending := make(chan chan Result, 2*workers)
+	results := make(chan Result)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range jobs {
+				job.result <- formatResult(ctx, job.index, job.address, config, options)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+
+		for index := 0; ; index++ {
+			var address *Address
+			var isOpen bool
+			select {
+			case <-ctx.Done():
+				return
+			case address, isOpen = <-addresses:
+				if !isOpen {
+					return
+				}
+			}
+
+			result := make(chan Result, 1)
+			select {
+			case <-ctx.Done():
+				return
+			case pending <- result:
+			}
+			jobs <- formatJob{index: index, address: address, result: result}
+		}
+	}()
+
+	go func() {
+		defer close(results)
+
+		for result := range pending {
+			select {
+			case <-ctx.Done():
+				return
+			case r := <-result:
+				select {
+				case <-ctx.Done():
+					return
+				case results <- r:
+				}
+			}
+		}
+	}()
+
+	return results
+}
+
+func formatResult(ctx context.Context, index int, address *Address, config *Config, options *options) Result {
+	result := Result{Index: index}
+
+	if result.Err = ctx.Err(); result.Err == nil {
+		result.Formatted, result.Err = formatAddress(ctx, address, config, options)
+	}
+
+	return result
+}
+
+func (o *options) getWorkers() int {
+	if o.workers < 1 {
+		return runtime.GOMAXPROCS(0)
+	}
+
+	return o.workers
+}
@@ -0,0 +1,136 @@
+package addrFmt
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchTestSuite))
+}
+
+type BatchTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *BatchTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = OneLine
+}
+
+func getBatchAddresses(count int) []*Address {
+	addresses := make([]*Address, count)
+	for i := range addresses {
+		addresses[i] = &Address{
+			Road:        "Platz der Republik",
+			HouseNumber: strconv.Itoa(i),
+			Postcode:    "11011",
+			City:        "Berlin",
+			CountryCode: "DE",
+		}
+	}
+
+	return addresses
+}
+
+func (suite *BatchTestSuite) TestFormatBatch() {
+	addresses := getBatchAddresses(100)
+	addresses[42] = nil
+
+	results, err := FormatBatch(context.Background(), addresses, suite.Config, WithWorkers(4))
+
+	suite.NoError(err)
+	suite.Len(results, 100)
+	for i, result := range results {
+		suite.Equal(i, result.Index)
+		if i == 42 {
+			suite.ErrorIs(result.Err, ErrNilAddress)
+			continue
+		}
+		suite.NoError(result.Err)
+		suite.Equal("Platz der Republik "+strconv.Itoa(i)+", 11011 Berlin", result.Formatted)
+	}
+}
+
+func (suite *BatchTestSuite) TestFormatBatchCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := FormatBatch(ctx, getBatchAddresses(10), suite.Config)
+
+	suite.ErrorIs(err, context.Canceled)
+	for _, result := range results {
+		suite.ErrorIs(result.Err, context.Canceled)
+	}
+}
+
+func (suite *BatchTestSuite) TestFormatStream() {
+	addresses := make(chan *Address)
+	go func() {
+		for _, address := range getBatchAddresses(100) {
+			addresses <- address
+		}
+		close(addresses)
+	}()
+
+	count := 0
+	for result := range FormatStream(context.Background(), addresses, suite.Config, WithWorkers(8)) {
+		suite.Equal(count, result.Index)
+		suite.NoError(result.Err)
+		suite.Equal("Platz der Republik "+strconv.Itoa(count)+", 11011 Berlin", result.Formatted)
+		count++
+	}
+	suite.Equal(100, count)
+}
+
+func (suite *BatchTestSuite) TestFormatStreamCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	addresses := make(chan *Address)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case addresses <- getBatchAddresses(1)[0]:
+			}
+		}
+	}()
+
+	results := FormatStream(ctx, addresses, suite.Config)
+	<-results
+	cancel()
+
+	for range results {
+	}
+}
+
+func (suite *BatchTestSuite) TestGetFixedAddressKeepsInput() {
+	addressMap := map[string]string{
+		"road":         "Platz der Republik",
+		"house_number": "1",
+		"postcode":     "11011",
+		"city":         "Berlin",
+		"state_code":   "DE-BE",
+		"province":     "Berlin",
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			address, err := GetFixedAddress(addressMap, suite.Config)
+			suite.NoError(err)
+			suite.Equal("DE", address.CountryCode)
+		}()
+	}
+	wg.Wait()
+
+	suite.Equal("DE-BE", addressMap["state_code"])
+	suite.NotContains(addressMap, "country_code")
+	suite.Len(addressMap, 6)
+}
@@ -0,0 +1,91 @@
+package addrFmt
+
+import (
+	"testing"
+)
+
+// the benchmarks run with the OpenCageData config, see copy-templates.cmd
+var benchmarkConfigFiles = ConfigFiles{
+	CountriesPath:     "templates/countries/worldwide.yaml",
+	ComponentsPath:    "templates/components.yaml",
+	StateCodesPath:    "templates/state_codes.yaml",
+	CountryToLangPath: "templates/country2lang.yaml",
+	CountyCodesPath:   "templates/county_codes.yaml",
+	CountryCodesPath:  "templates/country_codes.yaml",
+	AbbreviationFiles: "templates/abbreviations/*.yaml",
+}
+
+// countries with different templates, replacements and postformat replacements of the config
+var benchmarkAddresses = map[string]*Address{
+	"DE":      {House: "Bundestag", Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", State: "Berlin", Country: "Deutschland", CountryCode: "DE"},
+	"US":      {Road: "Pennsylvania Avenue NW", HouseNumber: "1600", City: "Washington", State: "District of Columbia", StateCode: "DC", Postcode: "20500", Country: "United States of America", CountryCode: "US"},
+	"GB":      {Road: "Downing Street", HouseNumber: "10", City: "London", Postcode: "SW1A 2AA", Country: "United Kingdom", CountryCode: "GB"},
+	"FR":      {Road: "Rue du Faubourg Saint-Honoré", HouseNumber: "55", City: "Paris", Postcode: "75008", State: "Île-de-France", Country: "France", CountryCode: "FR"},
+	"IT":      {Road: "Via del Quirinale", HouseNumber: "1", City: "Roma", Postcode: "00187", State: "Lazio", County: "Roma Capitale", Country: "Italia", CountryCode: "IT"},
+	"ES":      {Road: "Carrera de San Jerónimo", HouseNumber: "39", City: "Madrid", Postcode: "28014", Country: "España", CountryCode: "ES"},
+	"IC":      {Road: "Calle Triana", HouseNumber: "1", City: "Las Palmas de Gran Canaria", Postcode: "35002", CountryCode: "IC"},
+	"NL":      {Road: "Binnenhof", HouseNumber: "1A", City: "Den Haag", Postcode: "2513 AA", Country: "Nederland", CountryCode: "NL"},
+	"CH":      {Road: "Bundesplatz", HouseNumber: "3", City: "Bern", State: "Bern", Postcode: "3005", Country: "Schweiz", CountryCode: "CH"},
+	"BR":      {Road: "Praça dos Três Poderes", City: "Brasília", State: "Distrito Federal", Postcode: "70150-900", Country: "Brasil", CountryCode: "BR"},
+	"CA":      {Road: "Wellington Street", HouseNumber: "111", City: "Ottawa", State: "Ontario", Postcode: "K1A 0A9", Country: "Canada", CountryCode: "CA"},
+	"AU":      {Road: "Parliament Drive", City: "Canberra", State: "Australian Capital Territory", Postcode: "2600", Country: "Australia", CountryCode: "AU"},
+	"IN":      {Road: "Rajpath", Suburb: "Vijay Chowk", City: "New Delhi", State: "Delhi", Postcode: "110011", Country: "India", CountryCode: "IN"},
+	"RU":      {Road: "Тверская улица", HouseNumber: "13", City: "Москва", Postcode: "125032", Country: "Россия", CountryCode: "RU"},
+	"CN":      {Road: "长安街", City: "北京市", CityDistrict: "东城区", Postcode: "100006", Country: "中国", CountryCode: "CN"},
+	"JP":      {Quarter: "西新宿二丁目", HouseNumber: "8-1", CityDistrict: "新宿区", State: "東京都", Postcode: "163-8001", Country: "日本", CountryCode: "JP"},
+	"KR":      {Road: "세종대로", HouseNumber: "209", City: "종로구", State: "서울특별시", Postcode: "03171", Country: "대한민국", CountryCode: "KR"},
+	"ZA":      {Road: "Government Avenue", City: "Cape Town", State: "Western Cape", Postcode: "8001", Country: "South Africa", CountryCode: "ZA"},
+	"BE":      {Road: "Rue de la Loi", HouseNumber: "16", City: "Bruxelles", Postcode: "1000", Country: "Belgique", CountryCode: "BE"},
+	"default": {Road: "Main Street", HouseNumber: "1", City: "Atlantis", Country: "Atlantis"},
+}
+
+func loadBenchmarkConfig(b *testing.B) *Config {
+	config, err := ReadConfig(benchmarkConfigFiles)
+	if err != nil {
+		b.Skipf("OpenCageData config is missing, run copy-templates.cmd: %v", err)
+	}
+
+	return config
+}
+
+func BenchmarkFormatAddress(b *testing.B) {
+	config := loadBenchmarkConfig(b)
+	config.OutputFormat = PostalFormat
+
+	for country, address := range benchmarkAddresses {
+		b.Run(country, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := FormatAddress(address, config); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetFixedAddress(b *testing.B) {
+	config := loadBenchmarkConfig(b)
+
+	for country, address := range benchmarkAddresses {
+		addressMap, _ := addressToMap(address)
+		addressMap["website"] = "https://example.com"
+
+		b.Run(country, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := GetFixedAddress(addressMap, config); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkLoadConfig(b *testing.B) {
+	loadBenchmarkConfig(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		LoadConfig(benchmarkConfigFiles)
+	}
+}
@@ -0,0 +1,125 @@
+// Command addrfmt-conformance runs OpenCageData testcase directories (or your own with the same structure) against the
+// formatter and reports the results per country and per case with unified diffs of the failures.
+//
+// Usage:
+//
+//	addrfmt-conformance [flags] [testcase directory ...]
+//
+// The exit code is 1 if a case fails and 2 if the config or the testcases cannot be read.
+package main
+
+import (
+	"flag"
+	"fmt"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/internal/testcase"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var reportFormats = []string{"text", "json", "junit"}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("addrfmt-conformance", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	configDir := flagSet.String("config", "templates", "directory of the OpenCage configuration (worldwide.yaml in countries/)")
+	format := flagSet.String("format", "text", "report format: "+strings.Join(reportFormats, ", "))
+	output := flagSet.String("o", "", "file to write the report to instead of stdout")
+	countries := flagSet.String("countries", "", "comma separated countries (testcase file names such as DE) to run, all if empty")
+	flagSet.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: addrfmt-conformance [flags] [testcase directory ...]")
+		flagSet.PrintDefaults()
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+
+	writeReport, isKnown := reportWriters[*format]
+	if !isKnown {
+		fmt.Fprintf(stderr, "addrfmt-conformance: unknown report format %q\n", *format)
+		return 2
+	}
+
+	config, err := addrFmt.ReadConfig(addrFmt.ConfigFiles{
+		CountriesPath:     filepath.Join(*configDir, "countries", "worldwide.yaml"),
+		ComponentsPath:    filepath.Join(*configDir, "components.yaml"),
+		StateCodesPath:    filepath.Join(*configDir, "state_codes.yaml"),
+		CountryToLangPath: filepath.Join(*configDir, "country2lang.yaml"),
+		CountyCodesPath:   filepath.Join(*configDir, "county_codes.yaml"),
+		CountryCodesPath:  filepath.Join(*configDir, "country_codes.yaml"),
+		AbbreviationFiles: filepath.Join(*configDir, "abbreviations", "*.yaml"),
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "addrfmt-conformance: %v\n", err)
+		return 2
+	}
+	// OpenCageData's expected output relies on fixed addresses with unknown components as attention
+	config.OutputFormat = addrFmt.PostalFormat
+	config.UnknownAsAttention = true
+
+	dirs := flagSet.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"testcases"}
+	}
+
+	var cases []testcase.Case
+	for _, dir := range dirs {
+		dirCases, err := testcase.LoadDir(dir)
+		if err != nil {
+			fmt.Fprintf(stderr, "addrfmt-conformance: %v\n", err)
+			return 2
+		}
+		cases = append(cases, filterCountries(dirCases, *countries)...)
+	}
+
+	r := runCases(cases, config)
+
+	writer := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(stderr, "addrfmt-conformance: %v\n", err)
+			return 2
+		}
+		defer file.Close()
+		writer = file
+	}
+
+	if err = writeReport(writer, r); err != nil {
+		fmt.Fprintf(stderr, "addrfmt-conformance: %v\n", err)
+		return 2
+	}
+
+	if r.Failed > 0 || r.Errors > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func filterCountries(cases []testcase.Case, countries string) []testcase.Case {
+	if countries == "" {
+		return cases
+	}
+
+	selected := make(map[string]bool)
+	for _, country := range strings.Split(countries, ",") {
+		selected[strings.ToUpper(strings.TrimSpace(country))] = true
+	}
+
+	var filtered []testcase.Case
+	for _, c := range cases {
+		if selected[c.Country] {
+			filtered = append(filtered, c)
+		}
+	}
+
+	return filtered
+}
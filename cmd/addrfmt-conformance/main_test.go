@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConformanceTestSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceTestSuite))
+}
+
+type ConformanceTestSuite struct {
+	suite.Suite
+	Dir string
+}
+
+// SetupTest adds a failing case to a copy of the testcases
+func (suite *ConformanceTestSuite) SetupTest() {
+	suite.Dir = suite.T().TempDir()
+
+	for _, name := range []string{"de.yaml", "us.yaml"} {
+		content, err := os.ReadFile(filepath.Join("../../testdata/testcases/countries", name))
+		suite.Require().NoError(err)
+		suite.Require().NoError(os.WriteFile(filepath.Join(suite.Dir, name), content, 0o600))
+	}
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.Dir, "es.yaml"), []byte(`---
+description: Congreso
+components:
+    road: Carrera de San Jerónimo
+    house_number: 39
+    postcode: 28014
+    city: Madrid
+    country_code: es
+expected: |
+    Calle de San Jerónimo, 39
+    28014 Madrid
+`), 0o600))
+}
+
+func (suite *ConformanceTestSuite) run(args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"-config", "../../testdata/conf"}, args...)
+
+	exitCode := run(args, &stdout, &stderr)
+
+	return stdout.String(), stderr.String(), exitCode
+}
+
+func (suite *ConformanceTestSuite) TestText() {
+	stdout, stderr, exitCode := suite.run(suite.Dir)
+
+	suite.Equal(1, exitCode, stderr)
+	suite.Equal(`COUNTRY  TOTAL  PASSED  FAILED  ERRORS
+DE       2      2       0       0
+ES       1      0       1       0
+US       1      1       0       0
+TOTAL    4      3       1       0
+
+FAIL es.yaml: Congreso
+--- expected
++++ actual
+@@ -1,2 +1,2 @@
+-Calle de San Jerónimo, 39
++Carrera de San Jerónimo 39
+ 28014 Madrid
+`, stdout)
+}
+
+func (suite *ConformanceTestSuite) TestJSON() {
+	stdout, stderr, exitCode := suite.run("-format", "json", suite.Dir)
+	suite.Equal(1, exitCode, stderr)
+
+	var r report
+	suite.Require().NoError(json.Unmarshal([]byte(stdout), &r))
+	suite.Equal(4, r.Total)
+	suite.Equal(1, r.Failed)
+	suite.Equal(countryResult{Country: "ES", Total: 1, Failed: 1}, r.Countries[1])
+	suite.Require().Len(r.Cases, 4)
+	suite.False(r.Cases[2].Passed)
+	suite.Equal("Carrera de San Jerónimo 39\n28014 Madrid\n", r.Cases[2].Actual)
+	suite.Contains(r.Cases[2].Diff, "+Carrera de San Jerónimo 39\n")
+}
+
+func (suite *ConformanceTestSuite) TestJUnit() {
+	path := filepath.Join(suite.T().TempDir(), "report.xml")
+	stdout, stderr, exitCode := suite.run("-format", "junit", "-o", path, suite.Dir)
+	suite.Equal(1, exitCode, stderr)
+	suite.Empty(stdout)
+
+	content, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+
+	var suites junitTestSuites
+	suite.Require().NoError(xml.Unmarshal(content, &suites))
+	suite.Equal(4, suites.Tests)
+	suite.Equal(1, suites.Failures)
+	suite.Require().Len(suites.Suites, 3)
+	suite.Equal("ES", suites.Suites[1].Name)
+	suite.Require().NotNil(suites.Suites[1].Cases[0].Failure)
+	suite.Contains(suites.Suites[1].Cases[0].Failure.Text, "-Calle de San Jerónimo, 39\n")
+	suite.Nil(suites.Suites[0].Cases[0].Failure)
+}
+
+func (suite *ConformanceTestSuite) TestCountries() {
+	stdout, stderr, exitCode := suite.run("-countries", "de, us", suite.Dir)
+
+	suite.Equal(0, exitCode, stderr)
+	suite.Contains(stdout, "TOTAL    3      3       0       0\n")
+}
+
+func (suite *ConformanceTestSuite) TestInvalidArguments() {
+	_, stderr, exitCode := suite.run("-format", "html", suite.Dir)
+	suite.Equal(2, exitCode)
+	suite.Contains(stderr, `unknown report format "html"`)
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.Dir, "fr.yaml"), []byte("components: [\n"), 0o600))
+	_, stderr, exitCode = suite.run(suite.Dir)
+	suite.Equal(2, exitCode)
+	suite.Contains(stderr, "fr.yaml: document 1")
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/internal/testcase"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+type report struct {
+	Total     int             `json:"total"`
+	Passed    int             `json:"passed"`
+	Failed    int             `json:"failed"`
+	Errors    int             `json:"errors"`
+	Countries []countryResult `json:"countries"`
+	Cases     []caseResult    `json:"cases"`
+}
+
+type countryResult struct {
+	Country string `json:"country"`
+	Total   int    `json:"total"`
+	Passed  int    `json:"passed"`
+	Failed  int    `json:"failed"`
+	Errors  int    `json:"errors"`
+}
+
+type caseResult struct {
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Index   int    `json:"index"`
+	Country string `json:"country"`
+	Passed  bool   `json:"passed"`
+	// Expected, Actual and Diff are only set for failed cases
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Diff     string `json:"diff,omitempty"`
+	// Error is set if the address could not be fixed or formatted
+	Error string `json:"error,omitempty"`
+}
+
+func runCases(cases []testcase.Case, config *addrFmt.Config) *report {
+	r := &report{Cases: make([]caseResult, 0, len(cases))}
+	countries := make(map[string]*countryResult)
+
+	for _, c := range cases {
+		result := runCase(c, config)
+		r.Cases = append(r.Cases, result)
+
+		country, hasCountry := countries[c.Country]
+		if !hasCountry {
+			country = &countryResult{Country: c.Country}
+			countries[c.Country] = country
+		}
+
+		r.Total++
+		country.Total++
+		switch {
+		case result.Error != "":
+			r.Errors++
+			country.Errors++
+		case result.Passed:
+			r.Passed++
+			country.Passed++
+		default:
+			r.Failed++
+			country.Failed++
+		}
+	}
+
+	r.Countries = make([]countryResult, 0, len(countries))
+	for _, country := range countries {
+		r.Countries = append(r.Countries, *country)
+	}
+	sort.Slice(r.Countries, func(i, j int) bool {
+		return r.Countries[i].Country < r.Countries[j].Country
+	})
+
+	return r
+}
+
+func runCase(c testcase.Case, config *addrFmt.Config) caseResult {
+	result := caseResult{Name: c.Name(), File: c.File, Index: c.Index, Country: c.Country}
+
+	address, err := addrFmt.GetFixedAddress(c.Components, config)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	formattedAddress, err := addrFmt.FormatAddress(address, config)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	actual := fmt.Sprint(formattedAddress)
+	if actual == c.Expected {
+		result.Passed = true
+		return result
+	}
+
+	result.Expected = c.Expected
+	result.Actual = actual
+	result.Diff = testcase.Diff(c.Expected, actual)
+
+	return result
+}
+
+var reportWriters = map[string]func(io.Writer, *report) error{
+	"text":  writeText,
+	"json":  writeJSON,
+	"junit": writeJUnit,
+}
+
+// writeText writes the results per country followed by the diffs and errors of the failed cases
+func writeText(w io.Writer, r *report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "COUNTRY\tTOTAL\tPASSED\tFAILED\tERRORS")
+	for _, country := range r.Countries {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", country.Country, country.Total, country.Passed, country.Failed, country.Errors)
+	}
+	fmt.Fprintf(tw, "TOTAL\t%d\t%d\t%d\t%d\n", r.Total, r.Passed, r.Failed, r.Errors)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, result := range r.Cases {
+		switch {
+		case result.Error != "":
+			fmt.Fprintf(w, "\nERROR %s: %s\n", result.Name, result.Error)
+		case !result.Passed:
+			fmt.Fprintf(w, "\nFAIL %s\n%s", result.Name, result.Diff)
+		}
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, r *report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit writes a test suite per country, so CI systems show which countries regressed
+func writeJUnit(w io.Writer, r *report) error {
+	suites := junitTestSuites{Tests: r.Total, Failures: r.Failed, Errors: r.Errors}
+	suiteIndex := make(map[string]int, len(r.Countries))
+	for _, country := range r.Countries {
+		suiteIndex[country.Country] = len(suites.Suites)
+		suites.Suites = append(suites.Suites, junitTestSuite{
+			Name:     country.Country,
+			Tests:    country.Total,
+			Failures: country.Failed,
+			Errors:   country.Errors,
+		})
+	}
+
+	for _, result := range r.Cases {
+		testCase := junitTestCase{Name: result.Name, ClassName: "addrfmt." + result.Country}
+		switch {
+		case result.Error != "":
+			testCase.Error = &junitMessage{Message: result.Error}
+		case !result.Passed:
+			testCase.Failure = &junitMessage{Message: "formatted address differs from the expected one", Text: result.Diff}
+		}
+
+		suite := &suites.Suites[suiteIndex[result.Country]]
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,311 @@
+// Command addrfmt-golden writes regression testcases in the format of OpenCageData's testcases from address samples.
+//
+// Usage:
+//
+//	addrfmt-golden [flags] samples.jsonl ...
+//	addrfmt-golden -update [flags] testcase directory or file ...
+//
+// Every line of a sample file is a map of address components. The samples are fixed and formatted like the testcases
+// are run and appended to <country code>.yaml in the output directory, samples already in the file are skipped.
+// With -update the expectations of existing testcases are rewritten with the current output and the changes are shown
+// as unified diffs, comments and other keys of the files are kept.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/internal/testcase"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("addrfmt-golden", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	configDir := flagSet.String("config", "templates", "directory of the OpenCage configuration (worldwide.yaml in countries/)")
+	outputDir := flagSet.String("out", "testcases", "directory to write the testcase files to")
+	update := flagSet.Bool("update", false, "rewrite the expectations of the testcases in the given directories or files")
+	flagSet.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: addrfmt-golden [flags] samples.jsonl ...")
+		fmt.Fprintln(stderr, "       addrfmt-golden -update [flags] testcase directory or file ...")
+		flagSet.PrintDefaults()
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+	if flagSet.NArg() == 0 {
+		flagSet.Usage()
+		return 2
+	}
+
+	config, err := addrFmt.ReadConfig(addrFmt.ConfigFiles{
+		CountriesPath:     filepath.Join(*configDir, "countries", "worldwide.yaml"),
+		ComponentsPath:    filepath.Join(*configDir, "components.yaml"),
+		StateCodesPath:    filepath.Join(*configDir, "state_codes.yaml"),
+		CountryToLangPath: filepath.Join(*configDir, "country2lang.yaml"),
+		CountyCodesPath:   filepath.Join(*configDir, "county_codes.yaml"),
+		CountryCodesPath:  filepath.Join(*configDir, "country_codes.yaml"),
+		AbbreviationFiles: filepath.Join(*configDir, "abbreviations", "*.yaml"),
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "addrfmt-golden: %v\n", err)
+		return 2
+	}
+	// testcases are run with fixed addresses and unknown components as attention
+	config.OutputFormat = addrFmt.PostalFormat
+	config.UnknownAsAttention = true
+
+	if *update {
+		return updateTestcases(flagSet.Args(), config, stdout, stderr)
+	}
+
+	return generateTestcases(flagSet.Args(), *outputDir, config, stdout, stderr)
+}
+
+func format(components map[string]string, config *addrFmt.Config) (*addrFmt.Address, string, error) {
+	address, err := addrFmt.GetFixedAddress(components, config)
+	if err != nil {
+		return nil, "", err
+	}
+
+	formattedAddress, err := addrFmt.FormatAddress(address, config)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return address, fmt.Sprint(formattedAddress), nil
+}
+
+// generateTestcases appends the samples to the testcase file of their country
+func generateTestcases(inputs []string, outputDir string, config *addrFmt.Config, stdout io.Writer, stderr io.Writer) int {
+	exitCode := 0
+	casesByFile := make(map[string][]testcase.Case)
+
+	for _, input := range inputs {
+		samples, err := readSamples(input)
+		if err != nil {
+			fmt.Fprintf(stderr, "addrfmt-golden: %s: %v\n", input, err)
+			exitCode = 1
+			continue
+		}
+
+		for _, s := range samples {
+			address, expected, err := format(s.components, config)
+			if err != nil {
+				fmt.Fprintf(stderr, "addrfmt-golden: %s:%d: %v\n", input, s.line, err)
+				exitCode = 1
+				continue
+			}
+
+			fileName := "unknown.yaml"
+			if address.CountryCode != "" {
+				fileName = strings.ToLower(address.CountryCode) + ".yaml"
+			}
+
+			casesByFile[fileName] = append(casesByFile[fileName], testcase.Case{
+				Description: fmt.Sprintf("%s:%d", filepath.Base(input), s.line),
+				Components:  s.components,
+				Expected:    expected,
+			})
+		}
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		fmt.Fprintf(stderr, "addrfmt-golden: %v\n", err)
+		return 1
+	}
+
+	for _, fileName := range sortedKeys(casesByFile) {
+		path := filepath.Join(outputDir, fileName)
+		added, err := appendCases(path, casesByFile[fileName])
+		if err != nil {
+			fmt.Fprintf(stderr, "addrfmt-golden: %s: %v\n", path, err)
+			exitCode = 1
+			continue
+		}
+		fmt.Fprintf(stdout, "%s: added %d testcases\n", path, added)
+	}
+
+	return exitCode
+}
+
+// appendCases appends the cases whose components are not in the file yet
+func appendCases(path string, cases []testcase.Case) (int, error) {
+	var existingCases []testcase.Case
+	if _, err := os.Stat(path); err == nil {
+		if existingCases, err = testcase.LoadFile(path); err != nil {
+			return 0, err
+		}
+	}
+
+	var newCases []testcase.Case
+	for _, c := range cases {
+		if !containsComponents(existingCases, c.Components) && !containsComponents(newCases, c.Components) {
+			newCases = append(newCases, c)
+		}
+	}
+	if len(newCases) == 0 {
+		return 0, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	// a file without a trailing line break would continue its last line with the document separator
+	if hasLineBreak, err := endsWithLineBreak(file); err != nil {
+		return 0, err
+	} else if !hasLineBreak {
+		if _, err = file.WriteString("\n"); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = testcase.Write(file, newCases); err != nil {
+		return 0, err
+	}
+
+	return len(newCases), file.Close()
+}
+
+// endsWithLineBreak reports whether the file is empty or ends with \n
+func endsWithLineBreak(file *os.File) (bool, error) {
+	fileInfo, err := file.Stat()
+	if err != nil || fileInfo.Size() == 0 {
+		return true, err
+	}
+
+	lastByte := make([]byte, 1)
+	if _, err = file.ReadAt(lastByte, fileInfo.Size()-1); err != nil {
+		return false, err
+	}
+
+	return lastByte[0] == '\n', nil
+}
+
+// updateTestcases rewrites the files whose expectations changed and shows the diffs
+func updateTestcases(paths []string, config *addrFmt.Config, stdout io.Writer, stderr io.Writer) int {
+	exitCode := 0
+
+	var cases []testcase.Case
+	for _, path := range paths {
+		var pathCases []testcase.Case
+		fileInfo, err := os.Stat(path)
+		if err == nil && fileInfo.IsDir() {
+			pathCases, err = testcase.LoadDir(path)
+		} else if err == nil {
+			pathCases, err = testcase.LoadFile(path)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "addrfmt-golden: %v\n", err)
+			return 2
+		}
+		cases = append(cases, pathCases...)
+	}
+
+	// expected output of the changed cases by file and document index
+	changes := make(map[string]map[int]string)
+	for _, c := range cases {
+		if _, actual, err := format(c.Components, config); err != nil {
+			fmt.Fprintf(stderr, "addrfmt-golden: %s: %v\n", c.Name(), err)
+			exitCode = 1
+		} else if actual != c.Expected {
+			fmt.Fprintf(stdout, "%s\n%s", c.Name(), testcase.Diff(c.Expected, actual))
+			if changes[c.File] == nil {
+				changes[c.File] = make(map[int]string)
+			}
+			changes[c.File][c.Index] = actual
+		}
+	}
+
+	paths = make([]string, 0, len(changes))
+	for path := range changes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if err := testcase.UpdateFile(path, changes[path]); err != nil {
+			fmt.Fprintf(stderr, "addrfmt-golden: %v\n", err)
+			exitCode = 1
+			continue
+		}
+		fmt.Fprintf(stdout, "updated %s\n", path)
+	}
+
+	return exitCode
+}
+
+func containsComponents(cases []testcase.Case, components map[string]string) bool {
+	for _, c := range cases {
+		if reflect.DeepEqual(c.Components, components) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type sample struct {
+	line       int
+	components map[string]string
+}
+
+// readSamples reads a JSON object of address components per line, empty lines are skipped
+func readSamples(path string) ([]sample, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var samples []sample
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		decoder := json.NewDecoder(strings.NewReader(scanner.Text()))
+		decoder.UseNumber()
+		var values map[string]interface{}
+		if err = decoder.Decode(&values); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		components := make(map[string]string, len(values))
+		for component, value := range values {
+			if value != nil {
+				components[component] = fmt.Sprint(value)
+			}
+		}
+		samples = append(samples, sample{line: line, components: components})
+	}
+
+	return samples, scanner.Err()
+}
+
+func sortedKeys(casesByFile map[string][]testcase.Case) []string {
+	keys := make([]string, 0, len(casesByFile))
+	for key := range casesByFile {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
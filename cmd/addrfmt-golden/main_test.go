@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/suite"
+	"github.com/timonmasberg/address-formatter/internal/testcase"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGoldenTestSuite(t *testing.T) {
+	suite.Run(t, new(GoldenTestSuite))
+}
+
+type GoldenTestSuite struct {
+	suite.Suite
+	Dir string
+}
+
+func (suite *GoldenTestSuite) SetupTest() {
+	suite.Dir = suite.T().TempDir()
+}
+
+func (suite *GoldenTestSuite) run(args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"-config", "../../testdata/conf"}, args...)
+
+	exitCode := run(args, &stdout, &stderr)
+
+	return stdout.String(), stderr.String(), exitCode
+}
+
+func (suite *GoldenTestSuite) writeSamples(content string) string {
+	path := filepath.Join(suite.Dir, "samples.jsonl")
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func (suite *GoldenTestSuite) TestGenerate() {
+	samples := suite.writeSamples(`{"road": "Platz der Republik", "house_number": 1, "postcode": "11011", "city": "Berlin", "country_code": "de"}
+
+{"street": "Downing Street", "house_number": "10", "city": "London", "country_code": "gb"}
+{"road": "Wilhelmstraße", "house_number": "140", "postcode": "10963", "city": "Berlin", "country_code": "DEU"}
+`)
+	outputDir := filepath.Join(suite.Dir, "testcases")
+
+	stdout, stderr, exitCode := suite.run("-out", outputDir, samples)
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal(filepath.Join(outputDir, "de.yaml")+": added 2 testcases\n"+filepath.Join(outputDir, "gb.yaml")+": added 1 testcases\n", stdout)
+
+	cases, err := testcase.LoadDir(outputDir)
+	suite.Require().NoError(err)
+	suite.Require().Len(cases, 3)
+	suite.Equal("samples.jsonl:1", cases[0].Description)
+	suite.Equal("1", cases[0].Components["house_number"])
+	suite.Equal("Platz der Republik 1\n11011 Berlin\n", cases[0].Expected)
+	suite.Equal("samples.jsonl:4", cases[1].Description)
+	suite.Equal("10 Downing Street\nLondon\n", cases[2].Expected)
+
+	// samples that are already in the files are skipped
+	stdout, stderr, exitCode = suite.run("-out", outputDir, samples)
+	suite.Equal(0, exitCode, stderr)
+	suite.Contains(stdout, "de.yaml: added 0 testcases\n")
+}
+
+func (suite *GoldenTestSuite) TestUpdate() {
+	path := filepath.Join(suite.Dir, "de.yaml")
+	suite.Require().NoError(os.WriteFile(path, []byte(`---
+# testcases of the Bundestag
+description: Bundestag
+components:
+    road: Platz der Republik
+    house_number: 1
+    postcode: "11011"
+    city: Berlin
+    country_code: de
+expected: |
+    Platz der Republik 1
+    D-11011 Berlin
+source: https://www.bundestag.de
+---
+description: no components yet
+---
+description: Ministerium
+components:
+    road: Wilhelmstraße
+    house_number: 140
+    city: Berlin
+    country_code: de
+expected: |
+    Wilhelmstraße 140
+    Berlin
+`), 0o600))
+
+	stdout, stderr, exitCode := suite.run("-update", suite.Dir)
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal("de.yaml: Bundestag\n--- expected\n+++ actual\n@@ -1,2 +1,2 @@\n Platz der Republik 1\n-D-11011 Berlin\n+11011 Berlin\nupdated "+path+"\n", stdout)
+
+	content, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Equal(`---
+# testcases of the Bundestag
+description: Bundestag
+components:
+    road: Platz der Republik
+    house_number: 1
+    postcode: "11011"
+    city: Berlin
+    country_code: de
+expected: |
+    Platz der Republik 1
+    11011 Berlin
+source: https://www.bundestag.de
+---
+description: no components yet
+---
+description: Ministerium
+components:
+    road: Wilhelmstraße
+    house_number: 140
+    city: Berlin
+    country_code: de
+expected: |
+    Wilhelmstraße 140
+    Berlin
+`, string(content))
+
+	stdout, stderr, exitCode = suite.run("-update", path)
+	suite.Equal(0, exitCode, stderr)
+	suite.Empty(stdout)
+}
+
+func (suite *GoldenTestSuite) TestAppendWithoutTrailingLineBreak() {
+	outputDir := filepath.Join(suite.Dir, "testcases")
+	suite.Require().NoError(os.MkdirAll(outputDir, 0o755))
+	path := filepath.Join(outputDir, "de.yaml")
+	suite.Require().NoError(os.WriteFile(path, []byte("---\ncomponents:\n    city: Berlin\n    country_code: de\nexpected: Berlin"), 0o600))
+
+	samples := suite.writeSamples(`{"road": "Platz der Republik", "house_number": 1, "postcode": "11011", "city": "Berlin", "country_code": "de"}`)
+	_, stderr, exitCode := suite.run("-out", outputDir, samples)
+	suite.Equal(0, exitCode, stderr)
+
+	cases, err := testcase.LoadFile(path)
+	suite.Require().NoError(err)
+	suite.Require().Len(cases, 2)
+	suite.Equal("Berlin", cases[0].Expected)
+	suite.Equal("Platz der Republik 1\n11011 Berlin\n", cases[1].Expected)
+}
+
+func (suite *GoldenTestSuite) TestInvalidSamples() {
+	samples := suite.writeSamples("{\"city\": \"Berlin\", \"country_code\": \"de\"}\n{\"city\": \n")
+
+	_, stderr, exitCode := suite.run("-out", filepath.Join(suite.Dir, "testcases"), samples)
+	suite.Equal(1, exitCode)
+	suite.True(strings.HasSuffix(strings.TrimSpace(stderr), "samples.jsonl: line 2: unexpected EOF"), stderr)
+
+	_, _, exitCode = suite.run()
+	suite.Equal(2, exitCode)
+}
@@ -0,0 +1,106 @@
+// Command addrfmt-server exposes the address formatter as JSON API over HTTP.
+//
+// Endpoints:
+//
+//	POST /format   formats {"components": {...}}, set "fix": true to fix the components first
+//	POST /fix      fixes {"components": {...}} into an address
+//	POST /parse    maps {"components": {...}} and their aliases to an address without fixing them
+//	POST /batch    formats {"addresses": [{...}, ...]}
+//	GET  /healthz  liveness
+//	GET  /readyz   readiness, fails once the server shuts down
+//	GET  /metrics  Prometheus metrics
+//
+// Requests may set "output_format" (postal, oneline, array), "language", "abbreviate" and "home_country".
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	configDir := flag.String("config", "templates", "directory of the OpenCage configuration (worldwide.yaml in countries/)")
+	requestTimeout := flag.Duration("request-timeout", 2*time.Second, "maximum duration of a request")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 15*time.Second, "maximum duration to finish running requests on shutdown")
+	shutdownDelay := flag.Duration("shutdown-delay", 0, "duration /readyz fails before the server stops accepting requests")
+	maxBodySize := flag.Int64("max-body-size", 1<<20, "maximum size of a request body in bytes")
+	unknownAsAttention := flag.Bool("unknown-as-attention", false, "treat unknown components as attention")
+	resolveCountryNames := flag.Bool("resolve-country-names", false, "determine missing country codes from the country name")
+	reloadInterval := flag.Duration("reload-interval", 0, "interval to check the configuration files for changes, 0 disables reloading")
+	flag.Parse()
+
+	configFiles := addrFmt.ConfigFiles{
+		CountriesPath:     filepath.Join(*configDir, "countries", "worldwide.yaml"),
+		ComponentsPath:    filepath.Join(*configDir, "components.yaml"),
+		StateCodesPath:    filepath.Join(*configDir, "state_codes.yaml"),
+		CountryToLangPath: filepath.Join(*configDir, "country2lang.yaml"),
+		CountyCodesPath:   filepath.Join(*configDir, "county_codes.yaml"),
+		CountryCodesPath:  filepath.Join(*configDir, "country_codes.yaml"),
+		AbbreviationFiles: filepath.Join(*configDir, "abbreviations", "*.yaml"),
+	}
+
+	configStore, err := addrFmt.NewConfigStore(configFiles,
+		addrFmt.WithConfigure(func(config *addrFmt.Config) {
+			config.UnknownAsAttention = *unknownAsAttention
+			config.ResolveCountryNames = *resolveCountryNames
+		}),
+		addrFmt.WithReloadCallback(func(_ *addrFmt.Config, err error) {
+			if err != nil {
+				log.Printf("Keeping the current configuration: %v", err)
+			} else {
+				log.Printf("Reloaded the configuration")
+			}
+		}),
+	)
+	if err != nil {
+		log.Fatalf("Could not load the configuration: %v", err)
+	}
+
+	s := newServer(configStore.Config, *requestTimeout, *maxBodySize)
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *reloadInterval > 0 {
+		go configStore.Watch(ctx, *reloadInterval)
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		log.Printf("Listening on %s", *addr)
+		serverErr <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		log.Fatalf("Server failed: %v", err)
+	case <-ctx.Done():
+	}
+
+	log.Printf("Shutting down")
+	s.setNotReady()
+	time.Sleep(*shutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("Shutdown failed: %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// upper bounds of the request duration histogram in seconds
+var durationBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// metrics are exposed in the Prometheus text format
+type metrics struct {
+	mutex             sync.Mutex
+	requests          map[string]uint64 // endpoint and status => count
+	durations         map[string]*histogram
+	formatted         map[string]uint64 // country code => count
+	fallbackTemplates map[string]uint64
+	errors            map[string]uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:          make(map[string]uint64),
+		durations:         make(map[string]*histogram),
+		formatted:         make(map[string]uint64),
+		fallbackTemplates: make(map[string]uint64),
+		errors:            make(map[string]uint64),
+	}
+}
+
+func (m *metrics) observeRequest(endpoint string, status int, duration time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.requests[fmt.Sprintf(`endpoint="%s",code="%d"`, endpoint, status)]++
+
+	h, hasHistogram := m.durations[endpoint]
+	if !hasHistogram {
+		h = &histogram{counts: make([]uint64, len(durationBuckets))}
+		m.durations[endpoint] = h
+	}
+
+	seconds := duration.Seconds()
+	for i, bucket := range durationBuckets {
+		if seconds <= bucket {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+func (m *metrics) observeFormat(countryCode string, usesFallbackTemplate bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.formatted[countryCode]++
+	if usesFallbackTemplate {
+		m.fallbackTemplates[countryCode]++
+	}
+}
+
+func (m *metrics) observeError(countryCode string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.errors[countryCode]++
+}
+
+func (m *metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.write(w)
+}
+
+func (m *metrics) write(w io.Writer) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	writeCounter(w, "addrfmt_requests_total", "Requests by endpoint and status code.", m.requests, "")
+	writeCounter(w, "addrfmt_formatted_total", "Formatted addresses by country code.", m.formatted, "country_code")
+	writeCounter(w, "addrfmt_fallback_template_total", "Addresses formatted with the fallback template by country code.", m.fallbackTemplates, "country_code")
+	writeCounter(w, "addrfmt_errors_total", "Addresses that could not be fixed or formatted by country code.", m.errors, "country_code")
+
+	fmt.Fprintln(w, "# HELP addrfmt_request_duration_seconds Request duration by endpoint.")
+	fmt.Fprintln(w, "# TYPE addrfmt_request_duration_seconds histogram")
+	endpoints := make([]string, 0, len(m.durations))
+	for endpoint := range m.durations {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	for _, endpoint := range endpoints {
+		h := m.durations[endpoint]
+		for i, bucket := range durationBuckets {
+			fmt.Fprintf(w, "addrfmt_request_duration_seconds_bucket{endpoint=\"%s\",le=\"%s\"} %d\n",
+				endpoint, strconv.FormatFloat(bucket, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(w, "addrfmt_request_duration_seconds_bucket{endpoint=\"%s\",le=\"+Inf\"} %d\n", endpoint, h.count)
+		fmt.Fprintf(w, "addrfmt_request_duration_seconds_sum{endpoint=\"%s\"} %s\n", endpoint, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "addrfmt_request_duration_seconds_count{endpoint=\"%s\"} %d\n", endpoint, h.count)
+	}
+}
+
+// writeCounter writes the series of a counter, the keys are label values of label or complete label pairs if label is empty
+func writeCounter(w io.Writer, name string, help string, values map[string]uint64, label string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", name)
+
+	for _, key := range sortedKeys(values) {
+		labels := key
+		if label != "" {
+			labels = fmt.Sprintf(`%s="%s"`, label, escapeLabelValue(key))
+		}
+		fmt.Fprintf(w, "%s{%s} %d\n", name, labels, values[key])
+	}
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+func sortedKeys(values map[string]uint64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/iso3166"
+	"net/http"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// request is the body of all endpoints, /batch uses addresses and the other endpoints components
+type request struct {
+	Components   map[string]string   `json:"components"`
+	Addresses    []map[string]string `json:"addresses"`
+	Fix          bool                `json:"fix"`
+	OutputFormat string              `json:"output_format"`
+	Language     string              `json:"language"`
+	Abbreviate   bool                `json:"abbreviate"`
+	HomeCountry  string              `json:"home_country"`
+}
+
+type response struct {
+	Address   *addrFmt.Address `json:"address,omitempty"`
+	Formatted interface{}      `json:"formatted,omitempty"`
+	Results   []batchResult    `json:"results,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+type batchResult struct {
+	Formatted interface{} `json:"formatted,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+var outputFormats = map[string]addrFmt.OutputFormat{
+	"":        addrFmt.PostalFormat,
+	"postal":  addrFmt.PostalFormat,
+	"oneline": addrFmt.OneLine,
+	"array":   addrFmt.Array,
+}
+
+var errBadRequest = errors.New("bad request")
+
+type server struct {
+	// getConfig returns the current config, each request uses a single snapshot
+	getConfig      func() *addrFmt.Config
+	metrics        *metrics
+	requestTimeout time.Duration
+	maxBodySize    int64
+	isReady        atomic.Value
+}
+
+func newServer(getConfig func() *addrFmt.Config, requestTimeout time.Duration, maxBodySize int64) *server {
+	s := &server{getConfig: getConfig, metrics: newMetrics(), requestTimeout: requestTimeout, maxBodySize: maxBodySize}
+	s.isReady.Store(true)
+
+	return s
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.Handle("/format", s.endpoint("format", s.format))
+	mux.Handle("/fix", s.endpoint("fix", s.fix))
+	mux.Handle("/parse", s.endpoint("parse", s.parse))
+	mux.Handle("/batch", s.endpoint("batch", s.batch))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !s.isReady.Load().(bool) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle("/metrics", s.metrics)
+
+	return mux
+}
+
+// setNotReady lets /readyz fail so load balancers stop sending requests before the server shuts down
+func (s *server) setNotReady() {
+	s.isReady.Store(false)
+}
+
+type endpointFunc func(ctx context.Context, req *request) (*response, error)
+
+// endpoint decodes the request, enforces the request timeout and records the metrics of the endpoint
+func (s *server) endpoint(name string, handle endpointFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		status := http.StatusOK
+		defer func() {
+			s.metrics.observeRequest(name, status, time.Since(start))
+		}()
+
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			status = http.StatusMethodNotAllowed
+			writeJSON(w, status, &response{Error: "method not allowed"})
+			return
+		}
+
+		var req request
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxBodySize))
+		if err := decoder.Decode(&req); err != nil {
+			status = http.StatusBadRequest
+			writeJSON(w, status, &response{Error: "invalid request body: " + err.Error()})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), s.requestTimeout)
+		defer cancel()
+
+		res, err := handle(ctx, &req)
+		switch {
+		case errors.Is(err, errBadRequest):
+			status = http.StatusBadRequest
+		case errors.Is(err, context.DeadlineExceeded):
+			status = http.StatusGatewayTimeout
+		case errors.Is(err, context.Canceled):
+			// the client is gone
+			status = 499
+		case err != nil:
+			status = http.StatusUnprocessableEntity
+		}
+
+		if err != nil {
+			res = &response{Error: err.Error()}
+		}
+		writeJSON(w, status, res)
+	})
+}
+
+// requestConfig applies the options of the request to a copy of the config
+func (s *server) requestConfig(req *request) (*addrFmt.Config, []addrFmt.Option, error) {
+	outputFormat, isKnown := outputFormats[req.OutputFormat]
+	if !isKnown {
+		return nil, nil, fmt.Errorf("%w: unknown output format %q", errBadRequest, req.OutputFormat)
+	}
+
+	config := *s.getConfig()
+	config.OutputFormat = outputFormat
+	config.Abbreviate = config.Abbreviate || req.Abbreviate
+
+	var opts []addrFmt.Option
+	if req.Language != "" {
+		opts = append(opts, addrFmt.WithLanguage(req.Language))
+	}
+	if req.HomeCountry != "" {
+		opts = append(opts, addrFmt.WithHomeCountry(req.HomeCountry))
+	}
+
+	return &config, opts, nil
+}
+
+func (s *server) format(ctx context.Context, req *request) (*response, error) {
+	if req.Components == nil {
+		return nil, fmt.Errorf("%w: components are missing", errBadRequest)
+	}
+
+	config, opts, err := s.requestConfig(req)
+	if err != nil {
+		return nil, err
+	}
+
+	formatted, err := s.formatComponents(ctx, req.Components, req.Fix, config, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response{Formatted: formatted}, nil
+}
+
+func (s *server) fix(ctx context.Context, req *request) (*response, error) {
+	if req.Components == nil {
+		return nil, fmt.Errorf("%w: components are missing", errBadRequest)
+	}
+
+	config, opts, err := s.requestConfig(req)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := addrFmt.GetFixedAddressContext(ctx, req.Components, config, opts...)
+	s.observe(address, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response{Address: address}, nil
+}
+
+func (s *server) parse(_ context.Context, req *request) (*response, error) {
+	if req.Components == nil {
+		return nil, fmt.Errorf("%w: components are missing", errBadRequest)
+	}
+
+	config := s.getConfig()
+
+	return &response{Address: addrFmt.MapToAddress(req.Components, config.ComponentAliases, config.UnknownAsAttention)}, nil
+}
+
+// batch fixes and formats all addresses concurrently, errors of single addresses are reported in their result
+func (s *server) batch(ctx context.Context, req *request) (*response, error) {
+	if req.Addresses == nil {
+		return nil, fmt.Errorf("%w: addresses are missing", errBadRequest)
+	}
+
+	config, opts, err := s.requestConfig(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]batchResult, len(req.Addresses))
+	indices := make(chan int)
+
+	// the addresses are fixed by the workers as well
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				formatted, err := s.formatComponents(ctx, req.Addresses[index], req.Fix, config, opts)
+
+				results[index] = batchResult{Formatted: formatted}
+				if err != nil {
+					results[index] = batchResult{Error: err.Error()}
+				}
+			}
+		}()
+	}
+
+	for index := range req.Addresses {
+		indices <- index
+	}
+	close(indices)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &response{Results: results}, nil
+}
+
+func (s *server) formatComponents(ctx context.Context, components map[string]string, fix bool, config *addrFmt.Config, opts []addrFmt.Option) (interface{}, error) {
+	address, err := toAddress(ctx, components, fix, config, opts)
+
+	var formatted interface{}
+	if err == nil {
+		formatted, err = addrFmt.FormatAddressContext(ctx, address, config, opts...)
+	}
+	s.observe(address, err)
+
+	return formatted, err
+}
+
+// toAddress fixes the components or only applies the component aliases
+func toAddress(ctx context.Context, components map[string]string, fix bool, config *addrFmt.Config, opts []addrFmt.Option) (*addrFmt.Address, error) {
+	if fix {
+		return addrFmt.GetFixedAddressContext(ctx, components, config, opts...)
+	}
+
+	return addrFmt.MapToAddress(components, config.ComponentAliases, config.UnknownAsAttention), nil
+}
+
+func (s *server) observe(address *addrFmt.Address, err error) {
+	if err != nil {
+		s.metrics.observeError(getCountryCodeLabel(address))
+	} else {
+		s.metrics.observeFormat(getCountryCodeLabel(address), addrFmt.UsesFallbackTemplate(address))
+	}
+}
+
+// the label is limited to known country codes to keep the number of series bounded
+func getCountryCodeLabel(address *addrFmt.Address) string {
+	if address == nil {
+		return "unknown"
+	}
+
+	if countryCode, isKnown := iso3166.ToAlpha2(address.CountryCode); isKnown {
+		return countryCode
+	}
+
+	return "unknown"
+}
+
+func writeJSON(w http.ResponseWriter, status int, res *response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+type ServerTestSuite struct {
+	suite.Suite
+	Server  *server
+	Handler http.Handler
+}
+
+func (suite *ServerTestSuite) SetupTest() {
+	config := addrFmt.LoadConfig(addrFmt.ConfigFiles{
+		CountriesPath:     "../../testdata/conf/countries/worldwide.yaml",
+		ComponentsPath:    "../../testdata/conf/components.yaml",
+		StateCodesPath:    "../../testdata/conf/state_codes.yaml",
+		CountryToLangPath: "../../testdata/conf/country2lang.yaml",
+		CountyCodesPath:   "../../testdata/conf/county_codes.yaml",
+		CountryCodesPath:  "../../testdata/conf/country_codes.yaml",
+		AbbreviationFiles: "../../testdata/conf/abbreviations/*.yaml",
+	})
+	suite.Server = newServer(func() *addrFmt.Config { return config }, time.Second, 1<<20)
+	suite.Handler = suite.Server.handler()
+}
+
+func (suite *ServerTestSuite) request(method string, path string, body string) (int, string) {
+	recorder := httptest.NewRecorder()
+	suite.Handler.ServeHTTP(recorder, httptest.NewRequest(method, path, strings.NewReader(body)))
+
+	responseBody, err := io.ReadAll(recorder.Result().Body)
+	suite.Require().NoError(err)
+
+	return recorder.Code, string(responseBody)
+}
+
+func (suite *ServerTestSuite) TestFormat() {
+	status, body := suite.request(http.MethodPost, "/format", `{
+		"components": {"street": "Platz der Republik", "house_number": "1", "postcode": "11011", "city": "Berlin", "country_code": "deu"},
+		"fix": true,
+		"output_format": "oneline"
+	}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.JSONEq(`{"formatted": "Platz der Republik 1, 11011 Berlin"}`, body)
+}
+
+func (suite *ServerTestSuite) TestFormatWithLanguage() {
+	status, body := suite.request(http.MethodPost, "/format", `{
+		"components": {"road": "Avenue de France", "house_number": "12", "postcode": "1202", "city": "Genève", "country": "Schweiz", "country_code": "CH"},
+		"output_format": "array",
+		"language": "fr",
+		"abbreviate": true
+	}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.JSONEq(`{"formatted": ["Av de France 12", "1202 Genève", "Suisse"]}`, body)
+}
+
+func (suite *ServerTestSuite) TestFix() {
+	status, body := suite.request(http.MethodPost, "/fix", `{"components": {"street": "Platz der Republik", "state_code": "DE-BE", "website": "https://www.bundestag.de"}}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.JSONEq(`{"address": {"road": "Platz der Republik", "state_code": "BE", "country_code": "DE"}}`, body)
+}
+
+func (suite *ServerTestSuite) TestFixReadsConfigOnce() {
+	config := suite.Server.getConfig()
+	reads := 0
+	suite.Server.getConfig = func() *addrFmt.Config {
+		reads++
+		return config
+	}
+
+	status, _ := suite.request(http.MethodPost, "/fix", `{"components": {"street": "Platz der Republik", "country_code": "DE"}}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(1, reads)
+}
+
+func (suite *ServerTestSuite) TestParse() {
+	status, body := suite.request(http.MethodPost, "/parse", `{"components": {"street": "Platz der Republik", "state_code": "DE-BE"}}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.JSONEq(`{"address": {"road": "Platz der Republik", "state_code": "DE-BE"}}`, body)
+}
+
+func (suite *ServerTestSuite) TestBatch() {
+	status, body := suite.request(http.MethodPost, "/batch", `{
+		"addresses": [
+			{"road": "Platz der Republik", "house_number": "1", "postcode": "11011", "city": "Berlin", "country_code": "DE"},
+			{"city": "London", "country_code": "GB"}
+		],
+		"output_format": "oneline"
+	}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.JSONEq(`{"results": [{"formatted": "Platz der Republik 1, 11011 Berlin"}, {"formatted": "London"}]}`, body)
+}
+
+func (suite *ServerTestSuite) TestBatchWithFix() {
+	status, body := suite.request(http.MethodPost, "/batch", `{
+		"addresses": [
+			{"street": "Platz der Republik", "house_number": "1", "postcode": "11011", "city": "Berlin", "state_code": "DE-BE"},
+			{"city": "London", "country_code": "gb"},
+			{"road": "Bundesplatz", "house_number": "3", "postcode": "3005", "city": "Bern", "country_code": "CH"}
+		],
+		"fix": true,
+		"output_format": "oneline"
+	}`)
+
+	suite.Equal(http.StatusOK, status)
+	suite.JSONEq(`{"results": [{"formatted": "Platz der Republik 1, 11011 Berlin"}, {"formatted": "London"}, {"formatted": "Bundesplatz 3, 3005 Bern"}]}`, body)
+}
+
+func (suite *ServerTestSuite) TestBadRequests() {
+	requests := map[string]string{
+		"/format": `{"components": {"road": "Platz der Republik"}, "output_format": "xml"}`,
+		"/fix":    `{}`,
+		"/parse":  `{"components": ["Platz der Republik"]}`,
+		"/batch":  `{"components": {}}`,
+	}
+
+	for path, body := range requests {
+		status, responseBody := suite.request(http.MethodPost, path, body)
+
+		suite.Equal(http.StatusBadRequest, status, path)
+
+		var res response
+		suite.NoError(json.Unmarshal([]byte(responseBody), &res))
+		suite.NotEmpty(res.Error, path)
+	}
+
+	status, _ := suite.request(http.MethodGet, "/format", "")
+	suite.Equal(http.StatusMethodNotAllowed, status)
+}
+
+func (suite *ServerTestSuite) TestHealthAndReadiness() {
+	status, _ := suite.request(http.MethodGet, "/healthz", "")
+	suite.Equal(http.StatusOK, status)
+	status, _ = suite.request(http.MethodGet, "/readyz", "")
+	suite.Equal(http.StatusOK, status)
+
+	suite.Server.setNotReady()
+
+	status, _ = suite.request(http.MethodGet, "/readyz", "")
+	suite.Equal(http.StatusServiceUnavailable, status)
+	status, _ = suite.request(http.MethodGet, "/healthz", "")
+	suite.Equal(http.StatusOK, status)
+}
+
+func (suite *ServerTestSuite) TestMetrics() {
+	suite.request(http.MethodPost, "/format", `{"components": {"road": "Platz der Republik", "country_code": "DE"}}`)
+	suite.request(http.MethodPost, "/format", `{"components": {"city": "Berlin", "country_code": "DE"}}`)
+	suite.request(http.MethodPost, "/format", `{"components": {"city": "Nowhere", "country_code": "ZZ"}}`)
+	suite.request(http.MethodPost, "/fix", `{}`)
+	suite.request(http.MethodPost, "/fix", `{"components": {"road": "Downing Street", "country_code": "gb"}}`)
+
+	status, body := suite.request(http.MethodGet, "/metrics", "")
+
+	suite.Equal(http.StatusOK, status)
+	suite.Contains(body, `addrfmt_requests_total{endpoint="format",code="200"} 3`)
+	suite.Contains(body, `addrfmt_requests_total{endpoint="fix",code="400"} 1`)
+	suite.Contains(body, `addrfmt_requests_total{endpoint="fix",code="200"} 1`)
+	suite.Contains(body, `addrfmt_formatted_total{country_code="DE"} 2`)
+	suite.Contains(body, `addrfmt_formatted_total{country_code="unknown"} 1`)
+	suite.Contains(body, `addrfmt_formatted_total{country_code="GB"} 1`)
+	suite.Contains(body, `addrfmt_fallback_template_total{country_code="DE"} 1`)
+	suite.Contains(body, `addrfmt_request_duration_seconds_bucket{endpoint="format",le="+Inf"} 3`)
+	suite.Contains(body, `addrfmt_request_duration_seconds_count{endpoint="fix"} 2`)
+}
@@ -0,0 +1,335 @@
+// Command addrfmt fixes and formats addresses read as JSON, JSON Lines, YAML or CSV from files or stdin.
+//
+// Usage:
+//
+//	addrfmt [flags] [file ...]
+//
+// Every record is a map of address components such as {"road": "Platz der Republik", "house_number": "1"},
+// CSV files name the components in their header row.
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var inputFormats = []string{"auto", "json", "jsonl", "yaml", "csv"}
+var outputFormats = []string{"postal", "oneline", "array", "json"}
+
+type flags struct {
+	configDir           string
+	inputFormat         string
+	outputFormat        string
+	abbreviate          bool
+	unknownAsAttention  bool
+	resolveCountryNames bool
+	language            string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	var f flags
+
+	flagSet := flag.NewFlagSet("addrfmt", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	flagSet.StringVar(&f.configDir, "config", "templates", "directory of the OpenCage configuration (worldwide.yaml in countries/)")
+	flagSet.StringVar(&f.inputFormat, "input", "auto", "input format: "+strings.Join(inputFormats, ", ")+" (auto uses the file extension, stdin is read as JSON)")
+	flagSet.StringVar(&f.outputFormat, "output", "postal", "output format: "+strings.Join(outputFormats, ", "))
+	flagSet.BoolVar(&f.abbreviate, "abbreviate", false, "abbreviate components such as Avenue => Ave")
+	flagSet.BoolVar(&f.unknownAsAttention, "unknown-as-attention", false, "treat unknown components as attention")
+	flagSet.BoolVar(&f.resolveCountryNames, "resolve-country-names", false, "determine missing country codes from the country name")
+	flagSet.StringVar(&f.language, "language", "", "language of state names, country names and abbreviations, e.g. fr")
+	flagSet.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: addrfmt [flags] [file ...]")
+		flagSet.PrintDefaults()
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return 2
+	}
+	if !contains(inputFormats, f.inputFormat) {
+		fmt.Fprintf(stderr, "addrfmt: unknown input format %q\n", f.inputFormat)
+		return 2
+	}
+	if !contains(outputFormats, f.outputFormat) {
+		fmt.Fprintf(stderr, "addrfmt: unknown output format %q\n", f.outputFormat)
+		return 2
+	}
+
+	config, err := loadConfig(f)
+	if err != nil {
+		fmt.Fprintf(stderr, "addrfmt: %v\n", err)
+		return 2
+	}
+	writer := bufio.NewWriter(stdout)
+	defer writer.Flush()
+
+	inputs := flagSet.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+
+	exitCode := 0
+	for _, input := range inputs {
+		records, err := readInput(input, f.inputFormat, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "addrfmt: %s: %v\n", input, err)
+			exitCode = 1
+			continue
+		}
+
+		for i, record := range records {
+			if err = writeRecord(writer, record, config, f); err != nil {
+				fmt.Fprintf(stderr, "addrfmt: %s: record %d: %v\n", input, i+1, err)
+				exitCode = 1
+			}
+		}
+	}
+
+	return exitCode
+}
+
+func loadConfig(f flags) (*addrFmt.Config, error) {
+	config, err := addrFmt.ReadConfig(addrFmt.ConfigFiles{
+		CountriesPath:     filepath.Join(f.configDir, "countries", "worldwide.yaml"),
+		ComponentsPath:    filepath.Join(f.configDir, "components.yaml"),
+		StateCodesPath:    filepath.Join(f.configDir, "state_codes.yaml"),
+		CountryToLangPath: filepath.Join(f.configDir, "country2lang.yaml"),
+		CountyCodesPath:   filepath.Join(f.configDir, "county_codes.yaml"),
+		CountryCodesPath:  filepath.Join(f.configDir, "country_codes.yaml"),
+		AbbreviationFiles: filepath.Join(f.configDir, "abbreviations", "*.yaml"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	config.Abbreviate = f.abbreviate
+	config.UnknownAsAttention = f.unknownAsAttention
+	config.ResolveCountryNames = f.resolveCountryNames
+
+	switch f.outputFormat {
+	case "oneline":
+		config.OutputFormat = addrFmt.OneLine
+	case "array", "json":
+		config.OutputFormat = addrFmt.Array
+	default:
+		config.OutputFormat = addrFmt.PostalFormat
+	}
+
+	return config, nil
+}
+
+func writeRecord(writer io.Writer, record map[string]string, config *addrFmt.Config, f flags) error {
+	var opts []addrFmt.Option
+	if f.language != "" {
+		opts = append(opts, addrFmt.WithLanguage(f.language))
+	}
+
+	address, err := addrFmt.GetFixedAddress(record, config, opts...)
+	if err != nil {
+		return err
+	}
+
+	formattedAddress, err := addrFmt.FormatAddress(address, config, opts...)
+	if err != nil {
+		return err
+	}
+
+	switch f.outputFormat {
+	case "array":
+		return json.NewEncoder(writer).Encode(formattedAddress)
+	case "json":
+		return json.NewEncoder(writer).Encode(struct {
+			Address   *addrFmt.Address `json:"address"`
+			Formatted interface{}      `json:"formatted"`
+		}{Address: address, Formatted: formattedAddress})
+	case "postal":
+		// the postal format ends with a line break
+		_, err = fmt.Fprint(writer, formattedAddress)
+	default:
+		_, err = fmt.Fprintf(writer, "%s\n", formattedAddress)
+	}
+
+	return err
+}
+
+func readInput(input string, inputFormat string, stdin io.Reader) ([]map[string]string, error) {
+	reader := stdin
+	if input != "-" {
+		file, err := os.Open(input)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	if inputFormat == "auto" {
+		inputFormat = getInputFormat(input)
+	}
+
+	switch inputFormat {
+	case "yaml":
+		return readYAML(reader)
+	case "csv":
+		return readCSV(reader)
+	default:
+		return readJSON(reader)
+	}
+}
+
+func getInputFormat(input string) string {
+	switch strings.ToLower(filepath.Ext(input)) {
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".csv":
+		return "csv"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	default:
+		return "json"
+	}
+}
+
+// readJSON reads a single object, an array of objects or one object per line
+func readJSON(reader io.Reader) ([]map[string]string, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	records := make([]map[string]string, 0)
+
+	for {
+		var value interface{}
+		if err := decoder.Decode(&value); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		values, isArray := value.([]interface{})
+		if !isArray {
+			values = []interface{}{value}
+		}
+
+		for _, v := range values {
+			record, err := toRecord(v)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+	}
+}
+
+// readYAML reads a mapping or a sequence of mappings per document. The scalars are taken as written, so postcodes such
+// as 01067 keep their leading zeros
+func readYAML(reader io.Reader) ([]map[string]string, error) {
+	decoder := yaml.NewDecoder(reader)
+	records := make([]map[string]string, 0)
+
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if len(document.Content) == 0 {
+			continue
+		}
+
+		nodes := []*yaml.Node{document.Content[0]}
+		if document.Content[0].Kind == yaml.SequenceNode {
+			nodes = document.Content[0].Content
+		}
+
+		for _, node := range nodes {
+			record, err := toYAMLRecord(node)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+	}
+}
+
+func toYAMLRecord(node *yaml.Node) (map[string]string, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: expected a mapping of address components", node.Line)
+	}
+
+	record := make(map[string]string, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		component, value := node.Content[i], node.Content[i+1]
+		if value.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("line %d: component %s is not a scalar", value.Line, component.Value)
+		}
+		if value.Tag != "!!null" {
+			record[component.Value] = value.Value
+		}
+	}
+
+	return record, nil
+}
+
+// readCSV reads records with the component names in the header row, empty cells are skipped
+func readCSV(reader io.Reader) ([]map[string]string, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	rows, err := csvReader.ReadAll()
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+
+	header := rows[0]
+	records := make([]map[string]string, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		record := make(map[string]string, len(header))
+		for i, value := range row {
+			if i < len(header) && value != "" {
+				record[strings.TrimSpace(header[i])] = value
+			}
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func toRecord(value interface{}) (map[string]string, error) {
+	components, isMap := value.(map[string]interface{})
+	if !isMap {
+		return nil, fmt.Errorf("expected an object of address components, got %T", value)
+	}
+
+	record := make(map[string]string, len(components))
+	for component, componentValue := range components {
+		if componentValue != nil {
+			record[component] = fmt.Sprint(componentValue)
+		}
+	}
+
+	return record, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
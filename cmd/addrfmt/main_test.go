@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCommandTestSuite(t *testing.T) {
+	suite.Run(t, new(CommandTestSuite))
+}
+
+type CommandTestSuite struct {
+	suite.Suite
+	Dir string
+}
+
+func (suite *CommandTestSuite) SetupTest() {
+	suite.Dir = suite.T().TempDir()
+}
+
+func (suite *CommandTestSuite) run(stdin string, args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"-config", "../../testdata/conf"}, args...)
+
+	exitCode := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return stdout.String(), stderr.String(), exitCode
+}
+
+func (suite *CommandTestSuite) writeFile(name string, content string) string {
+	path := filepath.Join(suite.Dir, name)
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func (suite *CommandTestSuite) TestJSONFromStdin() {
+	stdout, stderr, exitCode := suite.run(`[
+		{"road": "Platz der Republik", "house_number": 1, "postcode": "11011", "city": "Berlin", "country_code": "de"},
+		{"street": "Downing Street", "house_number": "10", "city": "London", "postcode": "SW1A 2AA", "country_code": "gb"}
+	]`)
+
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal("Platz der Republik 1\n11011 Berlin\n10 Downing Street\nLondon\nSW1A 2AA\n", stdout)
+}
+
+func (suite *CommandTestSuite) TestJSONLines() {
+	stdout, stderr, exitCode := suite.run(
+		"{\"road\": \"Avenue de France\", \"house_number\": \"12\", \"postcode\": \"1202\", \"city\": \"Genève\", \"country\": \"Schweiz\", \"country_code\": \"CH\"}\n"+
+			"{\"road\": \"Platz der Republik\", \"house_number\": \"1\", \"postcode\": \"11011\", \"city\": \"Berlin\", \"country_code\": \"DE\"}\n",
+		"-output", "oneline", "-abbreviate", "-language", "fr")
+
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal("Av de France 12, 1202 Genève, Suisse\nPlatz der Republik 1, 11011 Berlin\n", stdout)
+}
+
+func (suite *CommandTestSuite) TestYAMLFile() {
+	path := suite.writeFile("addresses.yml", `
+- road: Platz der Republik
+  house_number: 1
+  postcode: "11011"
+  city: Berlin
+  country_code: DE
+  mutti: Angela Merkel
+`)
+
+	stdout, stderr, exitCode := suite.run("", "-output", "array", "-unknown-as-attention", path)
+
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal("[\"Angela Merkel\",\"Platz der Republik 1\",\"11011 Berlin\"]\n", stdout)
+}
+
+func (suite *CommandTestSuite) TestYAMLLeadingZeros() {
+	path := suite.writeFile("addresses.yaml", `
+road: Schloßplatz
+house_number: 1
+postcode: 01067
+city: Dresden
+country_code: DE
+---
+road: Karl Johans gate
+house_number: 1
+postcode: 0159
+city: Oslo
+country_code: NO
+`)
+
+	stdout, stderr, exitCode := suite.run("", "-output", "oneline", path)
+
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal("Schloßplatz 1, 01067 Dresden\nKarl Johans gate 1, 0159 Oslo\n", stdout)
+}
+
+func (suite *CommandTestSuite) TestCSVFile() {
+	path := suite.writeFile("addresses.csv", "road,house_number,postcode,city,country\n"+
+		"Platz der Republik,1,11011,Berlin,Deutschland\n"+
+		"Carrera de San Jerónimo,39,28014,Madrid,\n")
+
+	stdout, stderr, exitCode := suite.run("", "-output", "json", "-resolve-country-names", path)
+
+	suite.Equal(0, exitCode, stderr)
+	suite.Equal(`{"address":{"house_number":"1","road":"Platz der Republik","city":"Berlin","postcode":"11011","country":"Deutschland","country_code":"DE"},"formatted":["Platz der Republik 1","11011 Berlin","Deutschland"]}
+{"address":{"house_number":"39","road":"Carrera de San Jerónimo","city":"Madrid","postcode":"28014"},"formatted":["Carrera de San Jerónimo 39","28014 Madrid"]}
+`, stdout)
+}
+
+func (suite *CommandTestSuite) TestInvalidRecord() {
+	stdout, stderr, exitCode := suite.run(`{"road": "Platz der Republik"} "Berlin"`)
+
+	suite.Equal(1, exitCode)
+	suite.Empty(stdout)
+	suite.Contains(stderr, "expected an object of address components")
+}
+
+func (suite *CommandTestSuite) TestMissingFile() {
+	_, stderr, exitCode := suite.run("", filepath.Join(suite.Dir, "missing.json"))
+
+	suite.Equal(1, exitCode)
+	suite.Contains(stderr, "missing.json")
+}
+
+func (suite *CommandTestSuite) TestMissingConfig() {
+	_, stderr, exitCode := suite.run("", "-config", filepath.Join(suite.Dir, "missing"))
+
+	suite.Equal(2, exitCode)
+	suite.Contains(stderr, "components.yaml")
+}
+
+func (suite *CommandTestSuite) TestUnknownOutputFormat() {
+	_, stderr, exitCode := suite.run("", "-output", "xml")
+
+	suite.Equal(2, exitCode)
+	suite.Contains(stderr, "unknown output format")
+}
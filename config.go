@@ -1,11 +1,14 @@
 package addrFmt
 
 import (
+	"errors"
+	"fmt"
 	"gopkg.in/yaml.v3"
 	"io/ioutil"
 	"log"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -35,47 +38,160 @@ const (
 )
 
 type Config struct {
-	ComponentAliases   map[string]componentAlias
-	Templates          map[string]template
-	StateCodes         map[string]map[string]interface{}
-	CountryToLang      map[string]interface{}
-	CountyCodes        map[string]map[string]interface{}
-	CountryCodes       map[string]string
-	Abbreviations      map[string]abbreviation
-	Abbreviate         bool
-	UnknownAsAttention bool
-	OutputFormat       OutputFormat
-}
-
-// LoadConfig parses the configuration files into a Config structure
+	ComponentAliases    map[string]componentAlias
+	Templates           map[string]template
+	StateCodes          map[string]map[string]interface{}
+	CountryToLang       map[string]interface{}
+	CountyCodes         map[string]map[string]interface{}
+	CountryCodes        map[string]string
+	Abbreviations       map[string]abbreviation
+	Abbreviate          bool
+	UnknownAsAttention  bool
+	ResolveCountryNames bool
+	AddContinent        bool
+	HomeCountry         string
+	OutputFormat        OutputFormat
+	// origins are the names of the layers the values come from, see Origin
+	origins map[string]string
+	// templateOverlay holds the templates of a tenant, see TenantRegistry
+	templateOverlay map[string]template
+	// cache holds the parsed templates and compiled patterns, copies of the config share it. Configs that are not
+	// created by the package have none and parse them on every use
+	cache *configCache
+}
+
+// LoadConfig parses the configuration files into a Config structure and exits if a file cannot be loaded
 func LoadConfig(configFiles ConfigFiles) *Config {
-	var config Config
+	config, err := ReadConfig(configFiles)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return config
+}
+
+// ReadConfig parses the configuration files into a Config structure like LoadConfig but returns the error of a file
+// that cannot be loaded
+func ReadConfig(configFiles ConfigFiles) (*Config, error) {
+	return readConfigFiles(configFiles, false)
+}
+
+// ConfigData holds the contents of the configuration files, e.g. embedded ones or ones that are not stored in files.
+// Abbreviations maps the language to the content of its abbreviation file
+type ConfigData struct {
+	Countries     []byte
+	Components    []byte
+	StateCodes    []byte
+	CountryToLang []byte
+	CountyCodes   []byte
+	CountryCodes  []byte
+	Abbreviations map[string][]byte
+}
+
+// ParseConfig parses the contents of the configuration files into a Config structure like ReadConfig, missing contents
+// are parsed as empty files. Malformed contents return an error, the config may still be rejected by Validate
+func ParseConfig(data ConfigData) (*Config, error) {
+	config := Config{cache: newConfigCache()}
+	var err error
+
+	if config.ComponentAliases, err = parseComponentsAliasesConfig(string(data.Components)); err != nil {
+		return nil, err
+	}
+	if config.CountryCodes, err = parseCountryCodesConfig(string(data.CountryCodes)); err != nil {
+		return nil, err
+	}
+
+	config.Abbreviations = make(map[string]abbreviation, len(data.Abbreviations))
+	for language, content := range data.Abbreviations {
+		var abbreviation abbreviation
+		if err = yaml.Unmarshal(content, &abbreviation); err != nil {
+			return nil, fmt.Errorf("Could not load abbreviations of %s: %w", language, err)
+		}
+		config.Abbreviations[language] = abbreviation
+	}
+
+	contents := []struct {
+		name    string
+		content []byte
+		config  interface{}
+	}{
+		{"countries", data.Countries, &config.Templates},
+		{"state codes", data.StateCodes, &config.StateCodes},
+		{"country to language", data.CountryToLang, &config.CountryToLang},
+		{"county codes", data.CountyCodes, &config.CountyCodes},
+	}
+	for _, content := range contents {
+		if err = yaml.Unmarshal(content.content, content.config); err != nil {
+			return nil, fmt.Errorf("Could not load %s: %w", content.name, err)
+		}
+	}
+
+	return &config, nil
+}
+
+// readConfigFiles skips files with an empty path if skipEmptyPaths is set
+func readConfigFiles(configFiles ConfigFiles, skipEmptyPaths bool) (*Config, error) {
+	config := Config{cache: newConfigCache()}
+	var err error
 
-	config.ComponentAliases = getComponentsAliasesConfig(configFiles.ComponentsPath)
-	config.Abbreviations = loadAbbreviationConfig(configFiles.AbbreviationFiles)
-	config.CountryCodes = loadCountryCodesConfig(configFiles.CountryCodesPath)
-	loadConfig(configFiles.CountriesPath, &config.Templates)
-	loadConfig(configFiles.StateCodesPath, &config.StateCodes)
-	loadConfig(configFiles.CountryToLangPath, &config.CountryToLang)
-	loadConfig(configFiles.CountyCodesPath, &config.CountyCodes)
+	if configFiles.ComponentsPath != "" || !skipEmptyPaths {
+		if config.ComponentAliases, err = getComponentsAliasesConfig(configFiles.ComponentsPath); err != nil {
+			return nil, err
+		}
+	}
+	if config.Abbreviations, err = loadAbbreviationConfig(configFiles.AbbreviationFiles); err != nil {
+		return nil, err
+	}
+	if configFiles.CountryCodesPath != "" || !skipEmptyPaths {
+		if config.CountryCodes, err = loadCountryCodesConfig(configFiles.CountryCodesPath); err != nil {
+			return nil, err
+		}
+	}
 
-	return &config
+	files := []struct {
+		path   string
+		config interface{}
+	}{
+		{configFiles.CountriesPath, &config.Templates},
+		{configFiles.StateCodesPath, &config.StateCodes},
+		{configFiles.CountryToLangPath, &config.CountryToLang},
+		{configFiles.CountyCodesPath, &config.CountyCodes},
+	}
+	for _, file := range files {
+		if file.path == "" && skipEmptyPaths {
+			continue
+		}
+		if err = loadConfig(file.path, file.config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &config, nil
 }
 
-func getFileContent(path string) string {
+func getFileContent(path string) (string, error) {
 	content, err := ioutil.ReadFile(path)
 
 	if err != nil {
-		log.Fatalf("Could not read %s: %v", path, err)
+		return "", fmt.Errorf("Could not read %s: %w", path, err)
 	}
 
-	return string(content)
+	return string(content), nil
 }
 
 var fileContentRegExp = regexp.MustCompile(` #`)
 
-func loadCountryCodesConfig(countryCodesPath string) map[string]string {
-	fileContent := getFileContent(countryCodesPath)
+func loadCountryCodesConfig(countryCodesPath string) (map[string]string, error) {
+	fileContent, err := getFileContent(countryCodesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCountryCodesConfig(fileContent)
+}
+
+func parseCountryCodesConfig(fileContent string) (map[string]string, error) {
 	fileContent = fileContentRegExp.ReplaceAllString(fileContent, "")
 
 	var countryCodes map[string]string
@@ -83,14 +199,22 @@ func loadCountryCodesConfig(countryCodesPath string) map[string]string {
 	err := yaml.Unmarshal([]byte(fileContent), &countryCodes)
 
 	if err != nil {
-		log.Fatalf("Could not load countries config file: %v", err)
+		return nil, fmt.Errorf("Could not load countries config file: %w", err)
+	}
+
+	return countryCodes, nil
+}
+
+func getComponentsAliasesConfig(componentsPath string) (map[string]componentAlias, error) {
+	componentFileContent, err := getFileContent(componentsPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return countryCodes
+	return parseComponentsAliasesConfig(componentFileContent)
 }
 
-func getComponentsAliasesConfig(componentsPath string) map[string]componentAlias {
-	componentFileContent := getFileContent(componentsPath)
+func parseComponentsAliasesConfig(componentFileContent string) (map[string]componentAlias, error) {
 	componentParts := strings.Split(componentFileContent, componentFileDelimiter)
 
 	componentAliases := make(map[string]componentAlias)
@@ -104,7 +228,7 @@ func getComponentsAliasesConfig(componentsPath string) map[string]componentAlias
 		err := yaml.Unmarshal([]byte(componentPart), &component)
 
 		if err != nil {
-			log.Fatalf("Could not load components config file: %v", err)
+			return nil, fmt.Errorf("Could not load components config file: %w", err)
 		}
 
 		if len(component.Aliases) > 0 {
@@ -114,20 +238,22 @@ func getComponentsAliasesConfig(componentsPath string) map[string]componentAlias
 		}
 	}
 
-	return componentAliases
+	return componentAliases, nil
 }
 
-func loadAbbreviationConfig(abbreviationPath string) map[string]abbreviation {
+func loadAbbreviationConfig(abbreviationPath string) (map[string]abbreviation, error) {
 	abbreviationFiles, err := filepath.Glob(abbreviationPath)
 	abbreviations := make(map[string]abbreviation, len(abbreviationFiles))
 
 	if err != nil {
-		log.Fatalf("Could not load abbreviation config file: %v", err)
+		return nil, fmt.Errorf("Could not load abbreviation config file: %w", err)
 	}
 
 	for _, filePath := range abbreviationFiles {
 		var abbreviation abbreviation
-		loadConfig(filePath, &abbreviation)
+		if err = loadConfig(filePath, &abbreviation); err != nil {
+			return nil, err
+		}
 
 		fileBase := filepath.Base(filePath)
 		language := fileBase[0 : len(fileBase)-len(filepath.Ext(fileBase))]
@@ -135,14 +261,151 @@ func loadAbbreviationConfig(abbreviationPath string) map[string]abbreviation {
 		abbreviations[language] = abbreviation
 	}
 
-	return abbreviations
+	return abbreviations, nil
 }
 
-func loadConfig(path string, config interface{}) {
-	fileContent := getFileContent(path)
-	err := yaml.Unmarshal([]byte(fileContent), config)
+func loadConfig(path string, config interface{}) error {
+	fileContent, err := getFileContent(path)
+	if err != nil {
+		return err
+	}
+
+	err = yaml.Unmarshal([]byte(fileContent), config)
 
 	if err != nil {
-		log.Fatalf("Could not load %s: %v", path, err)
+		return fmt.Errorf("Could not load %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Validate checks that addresses can be rendered with the templates: the default template has an address and a
+// fallback template, all template texts parse and all replacements are pairs of a valid pattern and a string
+func (config *Config) Validate() error {
+	templates := config.getTemplates()
+	if err := validateDefaultTemplate(templates); err != nil {
+		return err
+	}
+
+	if err := validateTemplates(config.Templates, templates); err != nil {
+		return err
+	}
+
+	return validateTemplates(config.templateOverlay, templates)
+}
+
+// validateDefaultTemplate checks that the default template has an address and a fallback template
+func validateDefaultTemplate(templates templateSet) error {
+	template, _ := templates.get("default")
+	defaultTemplate, isTemplateMap := template.(map[string]interface{})
+	if !isTemplateMap {
+		return errors.New("default template is missing")
+	}
+
+	for _, key := range []string{"address_template", "fallback_template"} {
+		if _, isString := defaultTemplate[key].(string); !isString {
+			return fmt.Errorf("default template has no %s", key)
+		}
+	}
+
+	return nil
+}
+
+// validateTemplates validates each template with the templates it may refer to
+func validateTemplates(templateMap map[string]template, templates templateSet) error {
+	names := make([]string, 0, len(templateMap))
+	for name := range templateMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := validateTemplate(templateMap[name], templates); err != nil {
+			return fmt.Errorf("template %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (config *Config) getTemplates() templateSet {
+	return templateSet{overlay: config.templateOverlay, templates: config.Templates, cache: config.cache}
+}
+
+func validateTemplate(template template, templates templateSet) error {
+	switch templateValue := template.(type) {
+	case string:
+		_, err := templates.cache.parseTemplate(templateValue)
+		return err
+	case map[string]interface{}:
+		for _, key := range []string{"address_template", "fallback_template"} {
+			if templateText, hasTemplate := templateValue[key]; hasTemplate {
+				if err := validateTemplate(templateText, templates); err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+			}
+		}
+
+		for _, key := range []string{"replace", "postformat_replace"} {
+			if replacements, hasReplacements := templateValue[key]; hasReplacements {
+				if err := validateReplacements(replacements, templates.cache); err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+			}
+		}
+
+		for _, key := range []string{"use_country", "change_country", "add_component"} {
+			if value, hasValue := templateValue[key]; hasValue {
+				if _, isString := value.(string); !isString {
+					return fmt.Errorf("%s is not a string", key)
+				}
+			}
+		}
+
+		if useCountry, hasUseCountry := templateValue["use_country"].(string); hasUseCountry {
+			if _, hasTemplate := templates.get(strings.ToUpper(useCountry)); !hasTemplate {
+				return fmt.Errorf("use_country %s has no template", useCountry)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unexpected template of type %T", template)
+	}
+}
+
+func validateReplacements(replacements interface{}, cache *configCache) error {
+	replacementList, isList := replacements.([]interface{})
+	if !isList {
+		return errors.New("replacements are not a list")
 	}
+
+	for i, replacement := range replacementList {
+		pattern, _, err := getReplacementRule(replacement)
+		if err != nil {
+			return fmt.Errorf("replacement %d: %w", i, err)
+		}
+
+		if _, err = cache.compilePattern(pattern); err != nil {
+			return fmt.Errorf("replacement %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// getReplacementRule returns the pattern and the replacement of a replace or postformat_replace rule
+func getReplacementRule(replacement interface{}) (string, string, error) {
+	pair, isList := replacement.([]interface{})
+	if !isList || len(pair) != 2 {
+		return "", "", errors.New("rule is not a pair")
+	}
+
+	pattern, isString := pair[0].(string)
+	replace, isReplacementString := pair[1].(string)
+	if !isString || !isReplacementString {
+		return "", "", errors.New("rule is not a pair of strings")
+	}
+
+	return pattern, replace, nil
 }
@@ -0,0 +1,193 @@
+package addrFmt
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConfigStore holds the current Config of the config files (or layers) and replaces it once the files changed and the
+// reloaded config is valid. Get the config once per address (or request) and use it for all calls, so every address is
+// formatted with a consistent snapshot while the config is reloaded
+type ConfigStore struct {
+	layers []ConfigLayer
+	config atomic.Value
+	// reloadMutex serializes reloads
+	reloadMutex sync.Mutex
+	fingerprint string
+	configure   func(*Config)
+	onReload    func(*Config, error)
+}
+
+// ConfigStoreOption changes how a ConfigStore loads the config
+type ConfigStoreOption func(*ConfigStore)
+
+// WithConfigure applies settings such as Abbreviate or OutputFormat to every loaded config before it is validated
+func WithConfigure(configure func(*Config)) ConfigStoreOption {
+	return func(s *ConfigStore) {
+		s.configure = configure
+	}
+}
+
+// WithReloadCallback is called after every reload with the new config or the error the current config is kept for
+func WithReloadCallback(onReload func(config *Config, err error)) ConfigStoreOption {
+	return func(s *ConfigStore) {
+		s.onReload = onReload
+	}
+}
+
+// NewConfigStore loads and validates the config files
+func NewConfigStore(configFiles ConfigFiles, opts ...ConfigStoreOption) (*ConfigStore, error) {
+	return NewLayeredConfigStore([]ConfigLayer{{Files: configFiles}}, opts...)
+}
+
+// NewLayeredConfigStore loads and validates the config layers like ReadLayeredConfig
+func NewLayeredConfigStore(layers []ConfigLayer, opts ...ConfigStoreOption) (*ConfigStore, error) {
+	s := &ConfigStore{layers: layers}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	fingerprint, err := getConfigLayersFingerprint(layers)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	s.config.Store(config)
+	s.fingerprint = fingerprint
+
+	return s, nil
+}
+
+// Config returns the current config, which must not be changed
+func (s *ConfigStore) Config() *Config {
+	return s.config.Load().(*Config)
+}
+
+// Reload loads and validates the config files and replaces the current config if they are valid
+func (s *ConfigStore) Reload() error {
+	s.reloadMutex.Lock()
+	defer s.reloadMutex.Unlock()
+
+	fingerprint, err := getConfigLayersFingerprint(s.layers)
+	if err == nil {
+		err = s.reload(fingerprint)
+	}
+
+	return err
+}
+
+// Watch polls the modification times and sizes of the config files every interval and reloads the config once they
+// changed, until ctx is done
+func (s *ConfigStore) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.reloadIfChanged()
+		}
+	}
+}
+
+func (s *ConfigStore) reloadIfChanged() {
+	s.reloadMutex.Lock()
+	defer s.reloadMutex.Unlock()
+
+	fingerprint, err := getConfigLayersFingerprint(s.layers)
+	switch {
+	case err != nil:
+		// a file is being replaced or was removed, keep the current config and reload once all files exist again
+		if s.fingerprint != "" {
+			s.fingerprint = ""
+			s.notify(nil, err)
+		}
+	case fingerprint != s.fingerprint:
+		_ = s.reload(fingerprint)
+	}
+}
+
+func (s *ConfigStore) reload(fingerprint string) error {
+	config, err := s.load()
+	if err == nil {
+		s.config.Store(config)
+	}
+	// an invalid config is not retried until the files change again
+	s.fingerprint = fingerprint
+	s.notify(config, err)
+
+	return err
+}
+
+func (s *ConfigStore) load() (*Config, error) {
+	config, err := ReadLayeredConfig(s.layers)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.configure != nil {
+		s.configure(config)
+	}
+
+	if err = config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return config, nil
+}
+
+func (s *ConfigStore) notify(config *Config, err error) {
+	if s.onReload != nil {
+		s.onReload(config, err)
+	}
+}
+
+// getConfigLayersFingerprint joins the paths, modification times and sizes of all config files of the layers
+func getConfigLayersFingerprint(layers []ConfigLayer) (string, error) {
+	var paths []string
+	for _, layer := range layers {
+		abbreviationFiles, err := filepath.Glob(layer.Files.AbbreviationFiles)
+		if err != nil {
+			return "", err
+		}
+		sort.Strings(abbreviationFiles)
+
+		paths = append(paths,
+			layer.Files.CountriesPath,
+			layer.Files.ComponentsPath,
+			layer.Files.StateCodesPath,
+			layer.Files.CountryToLangPath,
+			layer.Files.CountyCodesPath,
+			layer.Files.CountryCodesPath,
+		)
+		paths = append(paths, abbreviationFiles...)
+	}
+
+	var fingerprint string
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+		fingerprint += fmt.Sprintf("%s:%d:%d\n", path, fileInfo.ModTime().UnixNano(), fileInfo.Size())
+	}
+
+	return fingerprint, nil
+}
@@ -0,0 +1,189 @@
+package addrFmt
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConfigStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigStoreTestSuite))
+}
+
+// ConfigStoreTestSuite changes a copy of testdata/conf
+type ConfigStoreTestSuite struct {
+	suite.Suite
+	ConfigFiles ConfigFiles
+	Address     *Address
+}
+
+func (suite *ConfigStoreTestSuite) SetupTest() {
+	dir := suite.T().TempDir()
+	suite.Require().NoError(copyDir("testdata/conf", dir))
+
+	suite.ConfigFiles = ConfigFiles{
+		CountriesPath:     filepath.Join(dir, "countries", "worldwide.yaml"),
+		ComponentsPath:    filepath.Join(dir, "components.yaml"),
+		StateCodesPath:    filepath.Join(dir, "state_codes.yaml"),
+		CountryToLangPath: filepath.Join(dir, "country2lang.yaml"),
+		CountyCodesPath:   filepath.Join(dir, "county_codes.yaml"),
+		CountryCodesPath:  filepath.Join(dir, "country_codes.yaml"),
+		AbbreviationFiles: filepath.Join(dir, "abbreviations", "*.yaml"),
+	}
+	suite.Address = &Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", CountryCode: "DE"}
+}
+
+func copyDir(src string, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, strings.TrimPrefix(path, src))
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		return ioutil.WriteFile(target, content, 0644)
+	})
+}
+
+// changeTemplates replaces old in the countries file by renaming a changed copy, which has a modification time in the
+// future so the change is seen even if the file system has a coarse timestamp resolution
+func (suite *ConfigStoreTestSuite) changeTemplates(old string, new string) {
+	path := suite.ConfigFiles.CountriesPath
+	content, err := ioutil.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(content), old)
+	suite.Require().NoError(ioutil.WriteFile(path+".tmp", []byte(strings.Replace(string(content), old, new, 1)), 0644))
+
+	modTime := time.Now().Add(time.Minute)
+	suite.Require().NoError(os.Chtimes(path+".tmp", modTime, modTime))
+	suite.Require().NoError(os.Rename(path+".tmp", path))
+}
+
+func (suite *ConfigStoreTestSuite) format(config *Config) string {
+	formattedAddress, err := FormatAddress(suite.Address, config)
+	suite.Require().NoError(err)
+
+	return formattedAddress.(string)
+}
+
+func (suite *ConfigStoreTestSuite) TestReadConfig() {
+	config, err := ReadConfig(suite.ConfigFiles)
+	suite.Require().NoError(err)
+	suite.NoError(config.Validate())
+
+	suite.ConfigFiles.StateCodesPath = "missing.yaml"
+	_, err = ReadConfig(suite.ConfigFiles)
+	suite.ErrorIs(err, os.ErrNotExist)
+}
+
+func (suite *ConfigStoreTestSuite) TestValidate() {
+	config, err := ReadConfig(suite.ConfigFiles)
+	suite.Require().NoError(err)
+
+	config.Templates["DE"].(map[string]interface{})["replace"] = []interface{}{[]interface{}{"(", ""}}
+	suite.EqualError(config.Validate(), "template DE: replace: replacement 0: error parsing regexp: missing closing ): `(`")
+
+	config.Templates["DE"] = map[string]interface{}{"address_template": "{{#first}}"}
+	err = config.Validate()
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "template DE: address_template: ")
+
+	config.Templates["DE"] = map[string]interface{}{"use_country": "XX"}
+	suite.EqualError(config.Validate(), "template DE: use_country XX has no template")
+
+	delete(config.Templates, "default")
+	suite.EqualError(config.Validate(), "default template is missing")
+}
+
+func (suite *ConfigStoreTestSuite) TestReload() {
+	var reloadErrs []error
+	store, err := NewConfigStore(suite.ConfigFiles,
+		WithConfigure(func(config *Config) {
+			config.OutputFormat = OneLine
+		}),
+		WithReloadCallback(func(config *Config, err error) {
+			reloadErrs = append(reloadErrs, err)
+		}),
+	)
+	suite.Require().NoError(err)
+
+	config := store.Config()
+	suite.Equal("Platz der Republik 1, 11011 Berlin", suite.format(config))
+
+	suite.changeTemplates(`["Berlin\nBerlin","Berlin"]`, `["(",""]`)
+	suite.Error(store.Reload())
+	suite.Same(config, store.Config())
+
+	suite.changeTemplates(`["(",""]`, `["^Platz","Pl."]`)
+	suite.NoError(store.Reload())
+	suite.NotSame(config, store.Config())
+	suite.Equal("Pl. der Republik 1, 11011 Berlin", suite.format(store.Config()))
+
+	suite.Require().Len(reloadErrs, 2)
+	suite.Require().Error(reloadErrs[0])
+	suite.Contains(reloadErrs[0].Error(), "invalid config: template DE: postformat_replace: ")
+	suite.NoError(reloadErrs[1])
+}
+
+func (suite *ConfigStoreTestSuite) TestWatch() {
+	reloaded := make(chan error, 1)
+	store, err := NewConfigStore(suite.ConfigFiles,
+		WithConfigure(func(config *Config) {
+			config.OutputFormat = PostalFormat
+		}),
+		WithReloadCallback(func(config *Config, err error) {
+			reloaded <- err
+		}),
+	)
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go store.Watch(ctx, 10*time.Millisecond)
+
+	suite.changeTemplates(`["Berlin\nBerlin","Berlin"]`, `["^Platz","Pl."]`)
+
+	select {
+	case err = <-reloaded:
+		suite.NoError(err)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("config was not reloaded")
+	}
+	suite.Equal("Pl. der Republik 1\n11011 Berlin\n", suite.format(store.Config()))
+}
+
+func (suite *ConfigStoreTestSuite) TestConcurrentReload() {
+	store, err := NewConfigStore(suite.ConfigFiles)
+	suite.Require().NoError(err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_, err := FormatAddress(suite.Address, store.Config())
+				suite.NoError(err)
+			}
+		}()
+	}
+
+	for i := 0; i < 5; i++ {
+		suite.NoError(store.Reload())
+	}
+	wg.Wait()
+}
@@ -0,0 +1,17 @@
+package addrFmt
+
+// testConfigFiles are the files of the reduced OpenCageData config in testdata/conf
+var testConfigFiles = ConfigFiles{
+	CountriesPath:     "testdata/conf/countries/worldwide.yaml",
+	ComponentsPath:    "testdata/conf/components.yaml",
+	StateCodesPath:    "testdata/conf/state_codes.yaml",
+	CountryToLangPath: "testdata/conf/country2lang.yaml",
+	CountyCodesPath:   "testdata/conf/county_codes.yaml",
+	CountryCodesPath:  "testdata/conf/country_codes.yaml",
+	AbbreviationFiles: "testdata/conf/abbreviations/*.yaml",
+}
+
+// readTestConfig loads the config in testdata/conf, every call returns a new config the test may modify
+func readTestConfig() *Config {
+	return LoadConfig(testConfigFiles)
+}
@@ -0,0 +1,74 @@
+package addrFmt
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+func TestContextTestSuite(t *testing.T) {
+	suite.Run(t, new(ContextTestSuite))
+}
+
+type ContextTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *ContextTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = OneLine
+}
+
+func (suite *ContextTestSuite) TestFormatAddressContext() {
+	address := &Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", CountryCode: "DE"}
+
+	formattedAddress, err := FormatAddressContext(context.Background(), address, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("Platz der Republik 1, 11011 Berlin", formattedAddress)
+}
+
+func (suite *ContextTestSuite) TestFormatAddressContextCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	formattedAddress, err := FormatAddressContext(ctx, &Address{City: "Berlin"}, suite.Config)
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Nil(formattedAddress)
+}
+
+func (suite *ContextTestSuite) TestFormatAddressContextDeadlineExceeded() {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	_, err := FormatAddressContext(ctx, &Address{City: "Berlin"}, suite.Config)
+
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (suite *ContextTestSuite) TestGetFixedAddressContext() {
+	addressMap := map[string]string{"road": "Platz der Republik", "country_code": "deu"}
+
+	address, err := GetFixedAddressContext(context.Background(), addressMap, suite.Config)
+	suite.NoError(err)
+	suite.Equal("DE", address.CountryCode)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	address, err = GetFixedAddressContext(ctx, addressMap, suite.Config)
+	suite.ErrorIs(err, context.Canceled)
+	suite.Nil(address)
+}
+
+func (suite *ContextTestSuite) TestCleanupRenderCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := cleanupRender(ctx, "Platz der Republik 1,\n\n11011 Berlin")
+
+	suite.ErrorIs(err, context.Canceled)
+}
@@ -0,0 +1,326 @@
+package addrFmt
+
+import (
+	"github.com/timonmasberg/address-formatter/iso3166"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// common abbreviations, colloquial names and misspellings not covered by the translated country names
+var countryNameAliases = map[string]string{
+	"america":               "US",
+	"us of a":               "US",
+	"united states america": "US",
+	"untied states":         "US",
+	"united sates":          "US",
+	"unites states":         "US",
+	"united state":          "US",
+	"uk":                    "GB",
+	"great britain":         "GB",
+	"britain":               "GB",
+	"england":               "GB",
+	"scotland":              "GB",
+	"wales":                 "GB",
+	"northern ireland":      "GB",
+	"untied kingdom":        "GB",
+	"netherland":            "NL",
+	"republic of korea":     "KR",
+	"korea south":           "KR",
+	"dprk":                  "KP",
+	"korea north":           "KP",
+	"ivory coast":           "CI",
+	"macedonia":             "MK",
+	"east timor":            "TL",
+	"turkey":                "TR",
+	"uae":                   "AE",
+	"emirates":              "AE",
+	"drc":                   "CD",
+	"dr congo":              "CD",
+	"congo kinshasa":        "CD",
+	"congo brazzaville":     "CG",
+	"vatican":               "VA",
+	"holy see":              "VA",
+	"bosnia":                "BA",
+	"kosovo":                "XK",
+	"prc":                   "CN",
+	"mainland china":        "CN",
+	"brd":                   "DE",
+	"germnay":               "DE",
+	"gemany":                "DE",
+	"phillipines":           "PH",
+	"philipines":            "PH",
+	"phillippines":          "PH",
+	"columbia":              "CO",
+	"argentinia":            "AR",
+	"swizerland":            "CH",
+	"switserland":           "CH",
+	"austrailia":            "AU",
+	"lichtenstein":          "LI",
+	"tailand":               "TH",
+	"portugual":             "PT",
+	"belguim":               "BE",
+	"new zeeland":           "NZ",
+	"republic of ireland":   "IE",
+	"srbija":                "RS",
+	"crna gora":             "ME",
+	"shqiperia":             "AL",
+	"saudi":                 "SA",
+	"trinidad":              "TT",
+	"st kitts":              "KN",
+	"st vincent":            "VC",
+	"nippon":                "JP",
+	"nihon":                 "JP",
+	"zhongguo":              "CN",
+	"hanguk":                "KR",
+	"rossiya":               "RU",
+	"россия":                "RU",
+	"bharat":                "IN",
+	"misr":                  "EG",
+	"ellada":                "GR",
+}
+
+// minimum length of a normalized country name to be matched with a single typo
+const fuzzyCountryNameMinLength = 6
+
+type countryNameIndex struct {
+	codes map[string]string
+	// neighbours maps the names that can be matched with a typo and the names with one deleted letter to the names,
+	// two names within one edit of each other share at least one entry
+	neighbours map[string][]string
+}
+
+var countryNameIndexOnce sync.Once
+var countryNameIdx *countryNameIndex
+
+// ResolveCountryCode determines the ISO 3166-1 alpha-2 code of a free-text country name such as
+// "United States of America", "U.S.A.", "Deutschland" or "España". It knows endonyms and exonyms in many
+// languages, ISO alpha-3 codes and common misspellings and additionally matches the country names of config if it is not nil
+func ResolveCountryCode(country string, config *Config) (string, bool) {
+	key := normalizeCountryName(country)
+	if key == "" {
+		return "", false
+	}
+
+	if code, isCode := resolveCountryCodeNotation(country); isCode {
+		return code, true
+	}
+
+	index := getCountryNameIndex()
+
+	if code, hasCode := index.codes[key]; hasCode {
+		return code, code != ""
+	}
+
+	if config != nil {
+		for code, name := range config.CountryCodes {
+			if normalizeCountryName(name) == key {
+				return strings.ToUpper(code), true
+			}
+		}
+	}
+
+	return index.fuzzyMatch(key)
+}
+
+func resolveCountryCodeNotation(country string) (string, bool) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+
+	switch len(country) {
+	case 2:
+		if alias, hasAlias := getCountryCodeAlias(country); hasAlias {
+			return alias, true
+		}
+		if _, isCountry := countryNames[country]; isCountry {
+			return country, true
+		}
+	case 3:
+		if isoCountry, isKnown := iso3166.Lookup(country); isKnown && isoCountry.Alpha3 == country {
+			return isoCountry.Alpha2, true
+		}
+	}
+
+	return "", false
+}
+
+func getCountryNameIndex() *countryNameIndex {
+	countryNameIndexOnce.Do(func() {
+		countryNameIdx = newCountryNameIndex()
+	})
+
+	return countryNameIdx
+}
+
+// names are indexed by priority, a name that is ambiguous within its priority is not resolved at all
+func newCountryNameIndex() *countryNameIndex {
+	index := &countryNameIndex{codes: make(map[string]string)}
+
+	aliases := make(map[string]string, len(countryNameAliases))
+	for alias, code := range countryNameAliases {
+		aliases[normalizeCountryName(alias)] = code
+	}
+	index.add(aliases)
+
+	englishNames := make(map[string]string, len(countryNames))
+	translatedNames := make(map[string]string)
+	for code, names := range countryNames {
+		for language, name := range names {
+			if language == "en" {
+				englishNames[normalizeCountryName(name)] = code
+			} else {
+				addCountryName(translatedNames, normalizeCountryName(name), code)
+			}
+		}
+	}
+	index.add(englishNames)
+	index.add(translatedNames)
+
+	variants := make(map[string]string)
+	for code, names := range countryNameVariants {
+		for _, name := range names {
+			addCountryName(variants, normalizeCountryName(name), code)
+		}
+	}
+	index.add(variants)
+	index.addNeighbours()
+
+	return index
+}
+
+func (index *countryNameIndex) addNeighbours() {
+	index.neighbours = make(map[string][]string)
+
+	for name, code := range index.codes {
+		// a name matches keys of the minimum length with a typo if it is at most one letter shorter
+		if code == "" || utf8.RuneCountInString(name) < fuzzyCountryNameMinLength-1 {
+			continue
+		}
+
+		for _, neighbour := range getDeletionNeighbours(name) {
+			index.neighbours[neighbour] = append(index.neighbours[neighbour], name)
+		}
+	}
+}
+
+// getDeletionNeighbours returns the name and the names with one deleted letter
+func getDeletionNeighbours(name string) []string {
+	neighbours := []string{name}
+	for i, r := range name {
+		neighbours = append(neighbours, name[:i]+name[i+utf8.RuneLen(r):])
+	}
+
+	return neighbours
+}
+
+// addCountryName marks names used by different countries as ambiguous with an empty code
+func addCountryName(names map[string]string, name string, code string) {
+	if existingCode, hasName := names[name]; hasName && existingCode != code {
+		names[name] = ""
+	} else {
+		names[name] = code
+	}
+}
+
+func (index *countryNameIndex) add(names map[string]string) {
+	for name, code := range names {
+		if _, isIndexed := index.codes[name]; !isIndexed && name != "" {
+			index.codes[name] = code
+		}
+	}
+}
+
+// fuzzyMatch resolves a name that is one typo away from exactly one country
+func (index *countryNameIndex) fuzzyMatch(key string) (string, bool) {
+	if utf8.RuneCountInString(key) < fuzzyCountryNameMinLength {
+		return "", false
+	}
+
+	matchedCode := ""
+	for _, neighbour := range getDeletionNeighbours(key) {
+		for _, name := range index.neighbours[neighbour] {
+			code := index.codes[name]
+			if code == matchedCode || !isWithinOneEdit(key, name) {
+				continue
+			}
+
+			if matchedCode != "" {
+				return "", false
+			}
+			matchedCode = code
+		}
+	}
+
+	return matchedCode, matchedCode != ""
+}
+
+// isWithinOneEdit reports whether a can be turned into b by one insertion, deletion, substitution or transposition
+func isWithinOneEdit(a string, b string) bool {
+	ar, br := []rune(a), []rune(b)
+	if len(ar) > len(br) {
+		ar, br = br, ar
+	}
+	if len(br)-len(ar) > 1 {
+		return false
+	}
+
+	prefix := 0
+	for prefix < len(ar) && ar[prefix] == br[prefix] {
+		prefix++
+	}
+	if prefix == len(ar) {
+		return true
+	}
+
+	if len(ar) == len(br) {
+		// substitution
+		if string(ar[prefix+1:]) == string(br[prefix+1:]) {
+			return true
+		}
+		// transposition
+		return prefix+1 < len(ar) && ar[prefix] == br[prefix+1] && ar[prefix+1] == br[prefix] &&
+			string(ar[prefix+2:]) == string(br[prefix+2:])
+	}
+
+	// insertion
+	return string(ar[prefix:]) == string(br[prefix+1:])
+}
+
+// normalizeCountryName lowercases, removes diacritics and punctuation and joins spelled out abbreviations (U. S. A. => usa)
+func normalizeCountryName(name string) string {
+	var builder strings.Builder
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == '.' || r == '\'' || r == '’':
+			continue
+		case r == '&':
+			builder.WriteString(" and ")
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			if folded, hasFolding := diacriticFoldings[r]; hasFolding {
+				builder.WriteString(folded)
+			} else if !unicode.Is(unicode.Mn, r) {
+				builder.WriteRune(r)
+			}
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	words := strings.Fields(builder.String())
+	if len(words) > 1 && words[0] == "the" {
+		words = words[1:]
+	}
+
+	normalizedWords := make([]string, 0, len(words))
+	for i, word := range words {
+		// join single letters such as "u s a"
+		if utf8.RuneCountInString(word) == 1 && i > 0 && utf8.RuneCountInString(words[i-1]) == 1 {
+			normalizedWords[len(normalizedWords)-1] += word
+		} else {
+			normalizedWords = append(normalizedWords, word)
+		}
+	}
+
+	return strings.Join(normalizedWords, " ")
+}
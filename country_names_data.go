@@ -0,0 +1,4301 @@
+package addrFmt
+
+// countryNames holds the display name of every ISO 3166-1 country per language, languages without
+// an own translation fall back to the english name
+var countryNames = map[string]map[string]string{
+	"AD": {
+		"en": "Andorra",
+		"fr": "Andorre",
+		"pl": "Andora",
+		"ru": "Андорра",
+		"el": "Ανδόρρα",
+		"ja": "アンドラ",
+		"zh": "安道尔",
+		"ko": "안도라",
+		"ar": "أندورا",
+	},
+	"AE": {
+		"en": "United Arab Emirates",
+		"de": "Vereinigte Arabische Emirate",
+		"fr": "Émirats arabes unis",
+		"es": "Emiratos Árabes Unidos",
+		"it": "Emirati Arabi Uniti",
+		"nl": "Verenigde Arabische Emiraten",
+		"pt": "Emirados Árabes Unidos",
+		"pl": "Zjednoczone Emiraty Arabskie",
+		"cs": "Spojené arabské emiráty",
+		"sv": "Förenade Arabemiraten",
+		"da": "Forenede Arabiske Emirater",
+		"nb": "De forente arabiske emirater",
+		"fi": "Yhdistyneet arabiemiirikunnat",
+		"ru": "Объединённые Арабские Эмираты",
+		"tr": "Birleşik Arap Emirlikleri",
+		"el": "Ηνωμένα Αραβικά Εμιράτα",
+		"ja": "アラブ首長国連邦",
+		"zh": "阿联酋",
+		"ko": "아랍에미리트",
+		"ar": "الإمارات العربيّة المتحدّة",
+	},
+	"AF": {
+		"en": "Afghanistan",
+		"es": "Afganistán",
+		"pt": "Afeganistão",
+		"pl": "Afganistan",
+		"cs": "Afghánistán",
+		"ru": "Афганистан",
+		"tr": "Afganistan",
+		"el": "Αφγανιστάν",
+		"ja": "アフガニスタン",
+		"zh": "阿富汗",
+		"ko": "아프가니스탄",
+		"ar": "أفغانستان",
+	},
+	"AG": {
+		"en": "Antigua and Barbuda",
+		"de": "Antigua und Barbuda",
+		"fr": "Antigua-et-Barbuda",
+		"es": "Antigua y Barbuda",
+		"it": "Antigua e Barbuda",
+		"nl": "Antigua en Barbuda",
+		"pt": "Antígua e Barbuda",
+		"pl": "Antigua i Barbuda",
+		"cs": "Antigua a Barbuda",
+		"sv": "Antigua och Barbuda",
+		"da": "Antigua og Barbuda",
+		"nb": "Antigua og Barbuda",
+		"fi": "Antigua ja Barbuda",
+		"ru": "Антигуа и Барбуда",
+		"tr": "Antigua ve Barbuda",
+		"el": "Αντίγκουα και Μπαρμπούντα",
+		"ja": "アンティグア・バーブーダ",
+		"zh": "安提瓜和巴布达",
+		"ko": "앤티가 바부다",
+		"ar": "أنتيغوا و باربودا",
+	},
+	"AI": {
+		"en": "Anguilla",
+		"es": "Anguila",
+		"ru": "Ангвилла",
+		"el": "Ανγκουίλα",
+		"ja": "アングイラ",
+		"zh": "安圭拉",
+		"ko": "앵귈라",
+		"ar": "أنغويلا",
+	},
+	"AL": {
+		"en": "Albania",
+		"de": "Albanien",
+		"fr": "Albanie",
+		"nl": "Albanië",
+		"pt": "Albânia",
+		"cs": "Albánie",
+		"sv": "Albanien",
+		"da": "Albanien",
+		"ru": "Албания",
+		"tr": "Arnavutluk",
+		"el": "Αλβανία",
+		"ja": "アルバニア",
+		"zh": "阿尔巴尼亚",
+		"ko": "알바니아",
+		"ar": "ألبانيا",
+	},
+	"AM": {
+		"en": "Armenia",
+		"de": "Armenien",
+		"fr": "Arménie",
+		"nl": "Armenië",
+		"pt": "Arménia",
+		"cs": "Arménie",
+		"sv": "Armenien",
+		"da": "Armenien",
+		"ru": "Армения",
+		"tr": "Ermenistan",
+		"el": "Αρμενία",
+		"ja": "アルメニア",
+		"zh": "亚美尼亚",
+		"ko": "아르메니아",
+		"ar": "أرمينيا",
+	},
+	"AO": {
+		"en": "Angola",
+		"ru": "Ангола",
+		"el": "Ανγκόλα",
+		"ja": "アンゴラ",
+		"zh": "安哥拉",
+		"ko": "앙골라",
+		"ar": "أنغولا",
+	},
+	"AQ": {
+		"en": "Antarctica",
+		"de": "Antarktis",
+		"fr": "Antarctique",
+		"es": "Antártida",
+		"it": "Antartide",
+		"pt": "Antártida",
+		"pl": "Antarktyka",
+		"cs": "Antarktida",
+		"sv": "Antarktis",
+		"da": "Antarktis",
+		"nb": "Antarktika",
+		"fi": "Antarktis",
+		"ru": "Антарктика",
+		"tr": "Antarktika",
+		"el": "Ανταρκτική",
+		"ja": "南極大陸",
+		"zh": "南极洲",
+		"ko": "남극",
+		"ar": "القطب الجنوبي",
+	},
+	"AR": {
+		"en": "Argentina",
+		"de": "Argentinien",
+		"fr": "Argentine",
+		"nl": "Argentinië",
+		"pl": "Argentyna",
+		"fi": "Argentiina",
+		"ru": "Аргентина",
+		"tr": "Arjantin",
+		"el": "Αργεντινή",
+		"ja": "アルゼンチン",
+		"zh": "阿根廷",
+		"ko": "아르헨티나",
+		"ar": "الأرجنتين",
+	},
+	"AS": {
+		"en": "American Samoa",
+		"de": "Amerikanisch-Samoa",
+		"fr": "Samoa américaines",
+		"es": "Samoa Estadounidense",
+		"it": "Samoa americane",
+		"nl": "Amerikaans-Samoa",
+		"pt": "Samoa Americana",
+		"pl": "Samoa Amerykańskie",
+		"cs": "Americká Samoa",
+		"sv": "Amerikanska Samoa",
+		"da": "Amerikansk Samoa",
+		"nb": "Amerikansk Samoa",
+		"fi": "Amerikan Samoa",
+		"ru": "Американские Самоа",
+		"tr": "Amerikan Samoası",
+		"el": "Αμερικανική Σαμόα",
+		"ja": "米領サモア",
+		"zh": "美属萨摩亚",
+		"ko": "아메리칸사모아",
+		"ar": "صاموا الأمريكيّة",
+	},
+	"AT": {
+		"en": "Austria",
+		"de": "Österreich",
+		"fr": "Autriche",
+		"nl": "Oostenrijk",
+		"pt": "Áustria",
+		"cs": "Rakousko",
+		"sv": "Österrike",
+		"da": "Østrig",
+		"nb": "Østerrike",
+		"fi": "Itävalta",
+		"ru": "Австрия",
+		"tr": "Avusturya",
+		"el": "Αυστρία",
+		"ja": "オーストリア",
+		"zh": "奥地利",
+		"ko": "오스트리아",
+		"ar": "النّمسا",
+	},
+	"AU": {
+		"en": "Australia",
+		"de": "Australien",
+		"fr": "Australie",
+		"nl": "Australië",
+		"pt": "Austrália",
+		"cs": "Austrálie",
+		"sv": "Australien",
+		"da": "Australien",
+		"ru": "Австралия",
+		"tr": "Avustralya",
+		"el": "Αυστραλία",
+		"ja": "オーストラリア連邦",
+		"zh": "澳大利亚",
+		"ko": "오스트레일리아",
+		"ar": "أستراليا",
+	},
+	"AW": {
+		"en": "Aruba",
+		"ru": "Аруба",
+		"el": "Αρούμπα",
+		"ja": "アルーバ",
+		"zh": "阿鲁巴",
+		"ko": "아루바",
+		"ar": "أروبا",
+	},
+	"AX": {
+		"en": "Åland Islands",
+		"de": "Åland-Inseln",
+		"es": "Islas Äland",
+		"it": "Isole Åland",
+		"nl": "Ålandseilanden",
+		"pt": "Ilhas Alanda",
+		"pl": "Wyspy Alandzkie",
+		"cs": "Ålandské ostrovy",
+		"sv": "Åland",
+		"da": "Åland",
+		"nb": "Åland",
+		"fi": "Ahvenanmaa",
+		"ru": "Аландские острова",
+		"tr": "Åland Adaları",
+		"el": "Νήσοι Ώλαντ",
+		"ja": "オーランド諸島",
+		"zh": "奥兰群岛",
+		"ko": "올란드 제도",
+		"ar": "جزر آلاند",
+	},
+	"AZ": {
+		"en": "Azerbaijan",
+		"de": "Aserbaidschan",
+		"fr": "Azerbaïdjan",
+		"es": "Azerbaiyán",
+		"it": "Azerbaigian",
+		"nl": "Azerbeidzjan",
+		"pt": "Azerbaijão",
+		"pl": "Azerbejdżan",
+		"cs": "Ázerbájdžán",
+		"sv": "Azerbajdzjan",
+		"da": "Aserbajdsjan",
+		"nb": "Aserbajdsjan",
+		"fi": "Azerbaidžan",
+		"ru": "Азербайджан",
+		"tr": "Azerbaycan",
+		"el": "Αζερμπαϊτζάν",
+		"ja": "アゼルバイジャン",
+		"zh": "阿塞拜疆",
+		"ko": "아제르바이잔",
+		"ar": "أذربيجان",
+	},
+	"BA": {
+		"en": "Bosnia and Herzegovina",
+		"de": "Bosnien und Herzegowina",
+		"fr": "Bosnie-Herzégovine",
+		"es": "Bosnia y Herzegovina",
+		"it": "Bosnia-Erzegovina",
+		"nl": "Bosnië en Herzegovina",
+		"pt": "Bósnia e Herzegovina",
+		"pl": "Bośnia i Hercegowina",
+		"cs": "Bosna a Hercegovina",
+		"sv": "Bosnien-Hercegovina",
+		"da": "Bosnien-Hercegovina",
+		"nb": "Bosnia-Hercegovina",
+		"fi": "Bosnia-Hertsegovina",
+		"ru": "Босния и Герцеговина",
+		"tr": "Bosna-Hersek",
+		"el": "Βοσνία και Ερζεγοβίνη",
+		"ja": "ボスニア・ヘルツェゴビナ",
+		"zh": "波斯尼亚和黑塞哥维那",
+		"ko": "보스니아 헤르체고비나",
+		"ar": "البوسنة و الهرسك",
+	},
+	"BB": {
+		"en": "Barbados",
+		"fr": "Barbade",
+		"ru": "Барбадос",
+		"el": "Μπαρμπάντος",
+		"ja": "バルバドス",
+		"zh": "巴巴多斯",
+		"ko": "바베이도스",
+		"ar": "بربادوس",
+	},
+	"BD": {
+		"en": "Bangladesh",
+		"de": "Bangladesch",
+		"es": "Bangladés",
+		"pt": "Bangladeche",
+		"pl": "Bangladesz",
+		"cs": "Bangladéš",
+		"ru": "Бангладеш",
+		"tr": "Bangladeş",
+		"el": "Μπανγκλαντές",
+		"ja": "バングラデシュ",
+		"zh": "孟加拉",
+		"ko": "방글라데시",
+		"ar": "بنغلادش",
+	},
+	"BE": {
+		"en": "Belgium",
+		"de": "Belgien",
+		"fr": "Belgique",
+		"es": "Bélgica",
+		"it": "Belgio",
+		"nl": "België",
+		"pt": "Bélgica",
+		"pl": "Belgia",
+		"cs": "Belgie",
+		"sv": "Belgien",
+		"da": "Belgien",
+		"nb": "Belgia",
+		"fi": "Belgia",
+		"ru": "Бельгия",
+		"tr": "Belçika",
+		"el": "Βέλγιο",
+		"ja": "ベルギー",
+		"zh": "比利时",
+		"ko": "벨기에",
+		"ar": "بلجيكا",
+	},
+	"BF": {
+		"en": "Burkina Faso",
+		"es": "Burquina Faso",
+		"ru": "Буркина-Фасо",
+		"el": "Μπουρκίνα Φάσο",
+		"ja": "ブルキナファソ",
+		"zh": "布基纳法索",
+		"ko": "부르키나파소",
+		"ar": "بوركينا فاصو",
+	},
+	"BG": {
+		"en": "Bulgaria",
+		"de": "Bulgarien",
+		"fr": "Bulgarie",
+		"nl": "Bulgarije",
+		"pt": "Bulgária",
+		"pl": "Bułgaria",
+		"cs": "Bulharsko",
+		"sv": "Bulgarien",
+		"da": "Bulgarien",
+		"ru": "Болгария",
+		"tr": "Bulgaristan",
+		"el": "Βουλγαρία",
+		"ja": "ブルガリア",
+		"zh": "保加利亚",
+		"ko": "불가리아",
+		"ar": "بلغاريا",
+	},
+	"BH": {
+		"en": "Bahrain",
+		"fr": "Bahreïn",
+		"es": "Baréin",
+		"it": "Bahrein",
+		"nl": "Bahrein",
+		"pt": "Barém",
+		"pl": "Bahrajn",
+		"cs": "Bahrajn",
+		"ru": "Бахрейн",
+		"tr": "Bahreyn",
+		"el": "Μπαχρέιν",
+		"ja": "バーレーン",
+		"zh": "巴林",
+		"ko": "바레인",
+		"ar": "البحرين",
+	},
+	"BI": {
+		"en": "Burundi",
+		"ru": "Бурунди",
+		"el": "Μπουρούντι",
+		"ja": "ブルンジ",
+		"zh": "布隆迪",
+		"ko": "부룬디",
+		"ar": "بوروندي",
+	},
+	"BJ": {
+		"en": "Benin",
+		"fr": "Bénin",
+		"es": "Benín",
+		"pt": "Benim",
+		"ru": "Бенин",
+		"el": "Μπενίν",
+		"ja": "ベナン",
+		"zh": "贝宁",
+		"ko": "베냉",
+		"ar": "بنين",
+	},
+	"BL": {
+		"en": "Saint Barthélemy",
+		"de": "Saint-Barthélemy",
+		"fr": "Saint-Barthélemy",
+		"es": "San Bartolomé",
+		"it": "Saint-Barthélemy",
+		"nl": "Saint-Barthélemy",
+		"pl": "Saint-Barthélemy",
+		"cs": "Svatý Bartoloměj",
+		"sv": "Saint-Barthélemy",
+		"da": "Sankt Bartolomæus",
+		"nb": "Saint-Barthélemy",
+		"ru": "Сен-Бартельми",
+		"el": "Άγιος Βαρθολομαίος",
+		"ja": "サンバルテルミ",
+		"zh": "圣巴泰勒米岛",
+		"ko": "생바르텔레미",
+		"ar": "سان بارتليمي",
+	},
+	"BM": {
+		"en": "Bermuda",
+		"fr": "Bermudes",
+		"es": "Islas Bermudas",
+		"pt": "Bermudas",
+		"pl": "Bermudy",
+		"cs": "Bermudy",
+		"ru": "Бермуды",
+		"el": "Βερμούδες",
+		"ja": "バーミューダ",
+		"zh": "百慕大",
+		"ko": "버뮤다",
+		"ar": "برمودا",
+	},
+	"BN": {
+		"en": "Brunei",
+		"fr": "Brunéi Darussalam",
+		"pl": "Państwo Brunei",
+		"cs": "Brunej",
+		"fi": "Brunei Darussalamin valtio",
+		"ru": "Бруней Даруссалам",
+		"tr": "Brunei Krallığı",
+		"el": "Μπρουνέι Νταρουσαλάμ",
+		"ja": "ブルネイ・ダルサラーム国",
+		"zh": "文莱",
+		"ko": "브루나이 다루살람",
+		"ar": "بروناي دار السّلام",
+	},
+	"BO": {
+		"en": "Bolivia",
+		"de": "Bolivien",
+		"fr": "Bolivie",
+		"pt": "Bolívia",
+		"pl": "Boliwia",
+		"cs": "Bolívie",
+		"ru": "Боливия",
+		"tr": "Bolivya",
+		"el": "Βολιβία",
+		"ja": "ボリビア",
+		"zh": "波利维亚",
+		"ko": "볼리비아",
+		"ar": "بوليفيا",
+	},
+	"BQ": {
+		"en": "Caribbean Netherlands",
+		"es": "Islas BES (Caribe Neerlandés)",
+		"it": "Paesi Bassi caraibici",
+		"ja": "ボネール、シントユースタティウス及びサバ",
+		"zh": "博奈尔、圣尤斯特歇斯岛和萨巴",
+		"ar": "بونير وسانت يوستاتيوس وسابا",
+	},
+	"BR": {
+		"en": "Brazil",
+		"de": "Brasilien",
+		"fr": "Brésil",
+		"es": "Brasil",
+		"it": "Brasile",
+		"nl": "Brazilië",
+		"pt": "Brasil",
+		"pl": "Brazylia",
+		"cs": "Brazílie",
+		"sv": "Brasilien",
+		"da": "Brasilien",
+		"nb": "Brasil",
+		"fi": "Brasilia",
+		"ru": "Бразилия",
+		"tr": "Brezilya",
+		"el": "Βραζιλία",
+		"ja": "ブラジル",
+		"zh": "巴西",
+		"ko": "브라질",
+		"ar": "البرازيل",
+	},
+	"BS": {
+		"en": "Bahamas",
+		"nl": "Bahama's",
+		"pl": "Bahamy",
+		"cs": "Bahamy",
+		"fi": "Bahama",
+		"ru": "Багамы",
+		"tr": "Bahamalar",
+		"el": "Μπαχάμες",
+		"ja": "バハマ",
+		"zh": "巴哈马",
+		"ko": "바하마",
+		"ar": "جزر البهاما",
+	},
+	"BT": {
+		"en": "Bhutan",
+		"fr": "Bhoutan",
+		"es": "Bután",
+		"pt": "Butão",
+		"cs": "Bhútán",
+		"ru": "Бутан",
+		"el": "Μπουτάν",
+		"ja": "ブータン",
+		"zh": "不丹",
+		"ko": "부탄",
+		"ar": "بوتان",
+	},
+	"BV": {
+		"en": "Bouvet Island",
+		"de": "Bouvet-Insel",
+		"fr": "île Bouvet",
+		"es": "Isla Bouvet",
+		"it": "Isola Bouvet",
+		"nl": "Bouveteiland",
+		"pt": "Ilha Bouvet",
+		"pl": "Wyspa Bouveta",
+		"cs": "Bouvetův ostrov",
+		"sv": "Bouvetön",
+		"da": "Bouvet-øen",
+		"nb": "Bouvetøya",
+		"fi": "Bouvet'nsaari",
+		"ru": "Остров Буве",
+		"tr": "Bouvet Adası",
+		"el": "Νήσος Μπουβέ",
+		"ja": "ブーベ島",
+		"zh": "布维群岛",
+		"ko": "부베 섬",
+		"ar": "جزيرة بوفي",
+	},
+	"BW": {
+		"en": "Botswana",
+		"de": "Botsuana",
+		"es": "Botsuana",
+		"pt": "Botsuana",
+		"ru": "Ботсвана",
+		"tr": "Botsvana",
+		"el": "Μποτσουάνα",
+		"ja": "ボツワナ",
+		"zh": "博兹瓦那",
+		"ko": "보츠와나",
+		"ar": "بوتسوانا",
+	},
+	"BY": {
+		"en": "Belarus",
+		"fr": "Bélarus",
+		"es": "Bielorrusia",
+		"it": "Bielorussia",
+		"nl": "Wit-Rusland",
+		"pt": "Bielorússia",
+		"pl": "Białoruś",
+		"cs": "Bělorusko",
+		"sv": "Vitryssland",
+		"da": "Hviderusland",
+		"nb": "Hviterussland",
+		"fi": "Valko-Venäjä",
+		"ru": "Беларусь",
+		"el": "Λευκορωσία",
+		"ja": "ベラルーシ",
+		"zh": "白俄罗斯",
+		"ko": "벨라루스",
+		"ar": "روسيا البيضاء",
+	},
+	"BZ": {
+		"en": "Belize",
+		"es": "Belice",
+		"ru": "Белиз",
+		"el": "Μπελίζ",
+		"ja": "ベリーズ",
+		"zh": "伯利兹",
+		"ko": "벨리즈",
+		"ar": "بيليز",
+	},
+	"CA": {
+		"en": "Canada",
+		"de": "Kanada",
+		"es": "Canadá",
+		"pt": "Canadá",
+		"pl": "Kanada",
+		"cs": "Kanada",
+		"sv": "Kanada",
+		"fi": "Kanada",
+		"ru": "Канада",
+		"tr": "Kanada",
+		"el": "Καναδάς",
+		"ja": "カナダ",
+		"zh": "加拿大",
+		"ko": "캐나다",
+		"ar": "كندا",
+	},
+	"CC": {
+		"en": "Cocos (Keeling) Islands",
+		"de": "Kokos-(Keeling-)Inseln",
+		"es": "Islas Cocos (Keeling)",
+		"it": "Isole Cocos (Keeling)",
+		"nl": "Cocoseilanden (Keelingeilanden)",
+		"pt": "Ilhas Cocos",
+		"pl": "Wyspy Kokosowe (Wyspy Keelinga)",
+		"cs": "Kokosové ostrovy",
+		"sv": "Kokosöarna",
+		"da": "Cocosøerne (Keelingøerne)",
+		"nb": "Kokosøyene",
+		"fi": "Kookossaaret",
+		"ru": "Кокосовые острова",
+		"tr": "Cocos (Keeling) Adaları",
+		"el": "Νήσοι Κόκος (Κήλινγκ)",
+		"ja": "ココス (キーリング) 諸島",
+		"zh": "科科斯群岛",
+		"ko": "코코스 제도",
+		"ar": "جزر الكوكوس",
+	},
+	"CD": {
+		"en": "Democratic Republic of the Congo",
+		"de": "Demokratische Republik Kongo",
+		"fr": "République démocratique du Congo",
+		"it": "Repubblica democratica del Congo",
+		"cs": "Konžská demokratická republika",
+		"da": "Den Demokratiske Republik Congo",
+		"fi": "Kongon demokraattinen tasavalta",
+		"ru": "Демократическая Республика Конго",
+		"tr": "Kongo Demokratik Cumhuriyeti",
+		"ja": "コンゴ民主共和国",
+		"zh": "刚果民主共和国",
+		"ko": "콩고 민주 공화국",
+		"ar": "الكونغو، جمهوريّة الكونغو الدّيموقراطيّة",
+	},
+	"CF": {
+		"en": "Central African Republic",
+		"de": "Zentralafrikanische Republik",
+		"fr": "République centrafricaine",
+		"es": "República Centroafricana",
+		"it": "Repubblica Centrafricana",
+		"nl": "Centraal-Afrikaanse Republiek",
+		"pt": "República Centro-Africana",
+		"pl": "Republika Środkowoafrykańska",
+		"cs": "Středoafrická republika",
+		"sv": "Centralafrikanska republiken",
+		"da": "Centralafrikanske Republik",
+		"nb": "Den sentralafrikanske republikk",
+		"fi": "Keski-Afrikan tasavalta",
+		"ru": "Центрально-африканская республика",
+		"tr": "Orta Afrika Cumhuriyeti",
+		"el": "Δημοκρατία Κεντρικής Αφρικής",
+		"ja": "中央アフリカ共和国",
+		"zh": "中非",
+		"ko": "중앙아프리카 공화국",
+		"ar": "جمهورية إفريقيّا الوسطى",
+	},
+	"CG": {
+		"en": "Republic of the Congo",
+		"de": "Kongo",
+		"fr": "République du Congo",
+		"pl": "Kongo",
+		"cs": "Kongo",
+		"sv": "Kongo",
+		"nb": "Kongo",
+		"fi": "Kongo",
+		"ru": "Конго",
+		"tr": "Kongo",
+		"el": "Κονγκό",
+		"ja": "コンゴ",
+		"zh": "刚果",
+		"ko": "콩고",
+		"ar": "الكونغو",
+	},
+	"CH": {
+		"en": "Switzerland",
+		"de": "Schweiz",
+		"fr": "Suisse",
+		"es": "Suiza",
+		"it": "Svizzera",
+		"nl": "Zwitserland",
+		"pt": "Suíça",
+		"pl": "Szwajcaria",
+		"cs": "Švýcarsko",
+		"sv": "Schweiz",
+		"da": "Schweiz",
+		"nb": "Sveits",
+		"fi": "Sveitsi",
+		"ru": "Швейцария",
+		"tr": "İsviçre",
+		"el": "Ελβετία",
+		"ja": "スイス",
+		"zh": "瑞士",
+		"ko": "스위스",
+		"ar": "سويسرا",
+	},
+	"CI": {
+		"en": "Côte d'Ivoire",
+		"es": "Costa de Marfíl",
+		"it": "Costa d'Avorio",
+		"nl": "Ivoorkust",
+		"pt": "Costa do Marfim",
+		"pl": "Wybrzeże Kości Słoniowej",
+		"cs": "Pobřeží slonoviny",
+		"sv": "Elfenbenskusten",
+		"da": "Elfenbenskysten",
+		"nb": "Elfenbenskysten",
+		"fi": "Norsunluurannikko",
+		"ru": "Кот-д'Ивуар",
+		"tr": "Fildişi Sahili",
+		"el": "Ακτή Ελεφαντοστού",
+		"ja": "コートジボワール",
+		"zh": "科特迪瓦",
+		"ko": "코트디부아르",
+		"ar": "ساحل العاج",
+	},
+	"CK": {
+		"en": "Cook Islands",
+		"de": "Cookinseln",
+		"fr": "îles Cook",
+		"es": "Islas Cook",
+		"it": "Isole Cook",
+		"nl": "Cookeilanden",
+		"pt": "Ilhas Cook",
+		"pl": "Wyspy Cooka",
+		"cs": "Cookovy ostrovy",
+		"sv": "Cooköarna",
+		"da": "Cookøerne",
+		"nb": "Cookøyene",
+		"fi": "Cookinsaaret",
+		"ru": "Острова Кука",
+		"tr": "Cook Adaları",
+		"el": "Νήσοι Κουκ",
+		"ja": "クック諸島",
+		"zh": "库克群岛",
+		"ko": "쿡 제도",
+		"ar": "جزر كوك",
+	},
+	"CL": {
+		"en": "Chile",
+		"fr": "Chili",
+		"it": "Cile",
+		"nl": "Chili",
+		"ru": "Чили",
+		"tr": "Şili",
+		"el": "Χιλή",
+		"ja": "チリ",
+		"zh": "智利",
+		"ko": "칠레",
+		"ar": "تشيلي",
+	},
+	"CM": {
+		"en": "Cameroon",
+		"de": "Kamerun",
+		"fr": "Cameroun",
+		"es": "Camerún",
+		"it": "Camerun",
+		"nl": "Kameroen",
+		"pt": "Camarões",
+		"pl": "Kamerun",
+		"cs": "Kamerun",
+		"sv": "Kamerun",
+		"da": "Cameroun",
+		"nb": "Kamerun",
+		"fi": "Kamerun",
+		"ru": "Камерун",
+		"tr": "Kamerun",
+		"el": "Καμερούν",
+		"ja": "カメルーン",
+		"zh": "喀麦隆",
+		"ko": "카메룬",
+		"ar": "الكاميرون",
+	},
+	"CN": {
+		"en": "China",
+		"fr": "Chine",
+		"it": "Cina",
+		"pl": "Chiny",
+		"cs": "Čína",
+		"sv": "Kina",
+		"da": "Kina",
+		"nb": "Kina",
+		"fi": "Kiina",
+		"ru": "Китай",
+		"tr": "Çin",
+		"el": "Κίνα",
+		"ja": "中国",
+		"zh": "中国",
+		"ko": "중국",
+		"ar": "الصّين",
+	},
+	"CO": {
+		"en": "Colombia",
+		"de": "Kolumbien",
+		"fr": "Colombie",
+		"pt": "Colômbia",
+		"pl": "Kolumbia",
+		"cs": "Kolumbie",
+		"fi": "Kolumbia",
+		"ru": "Колумбия",
+		"tr": "Kolombiya",
+		"el": "Κολομβία",
+		"ja": "コロンビア",
+		"zh": "哥伦比亚",
+		"ko": "콜롬비아",
+		"ar": "كولومبيا",
+	},
+	"CR": {
+		"en": "Costa Rica",
+		"pl": "Kostaryka",
+		"cs": "Kostarika",
+		"ru": "Коста-Рика",
+		"tr": "Kosta Rika",
+		"el": "Κόστα Ρίκα",
+		"ja": "コスタリカ",
+		"zh": "哥斯达黎加",
+		"ko": "코스타리카",
+		"ar": "كوستاريكا",
+	},
+	"CU": {
+		"en": "Cuba",
+		"de": "Kuba",
+		"pl": "Kuba",
+		"cs": "Kuba",
+		"sv": "Kuba",
+		"fi": "Kuuba",
+		"ru": "Куба",
+		"tr": "Küba",
+		"el": "Κούβα",
+		"ja": "キューバ",
+		"zh": "古巴",
+		"ko": "쿠바",
+		"ar": "كوبا",
+	},
+	"CV": {
+		"en": "Cape Verde",
+		"de": "Kap Verde",
+		"fr": "Cap-Vert",
+		"it": "Capo Verde",
+		"nl": "Kaapverdië",
+		"pl": "Republika Zielonego Przylądka",
+		"cs": "Kapverdské ostrovy",
+		"sv": "Kap Verde",
+		"da": "Kap Verde",
+		"nb": "Kapp Verde",
+		"ru": "Кабо-Верде",
+		"tr": "Yeşil Burun Adaları",
+		"el": "Πράσινο Ακρωτήριο",
+		"ja": "カーボヴェルデ",
+		"zh": "佛得角",
+		"ko": "카보베르데",
+		"ar": "الرأس الأخضر",
+	},
+	"CW": {
+		"en": "Curaçao",
+		"es": "Curazao",
+		"pt": "Curação",
+		"ru": "Кюрасао",
+		"el": "Κουρασάο",
+		"ja": "キュラソー",
+		"zh": "库拉索",
+		"ko": "퀴라소",
+		"ar": "جزر كوراكاو",
+	},
+	"CX": {
+		"en": "Christmas Island",
+		"de": "Weihnachtsinseln",
+		"es": "Isla de Navidad",
+		"it": "Isola di Natale",
+		"nl": "Christmaseiland",
+		"pt": "Ilha Natal",
+		"pl": "Wyspa Bożego Narodzenia",
+		"cs": "Vánoční ostrov",
+		"sv": "Julön",
+		"da": "Juleøen",
+		"nb": "Christmasøya",
+		"fi": "Joulusaari",
+		"ru": "Остров Рождества",
+		"tr": "Christmas Adası",
+		"el": "Νήσοι Χριστουγέννων",
+		"ja": "クリスマス島",
+		"zh": "圣诞岛",
+		"ko": "크리스마스 섬",
+		"ar": "جزر الكريسماس",
+	},
+	"CY": {
+		"en": "Cyprus",
+		"de": "Zypern",
+		"fr": "Chypre",
+		"es": "Chipre",
+		"it": "Cipro",
+		"pt": "Chipre",
+		"pl": "Cypr",
+		"cs": "Kypr",
+		"sv": "Cypern",
+		"da": "Cypern",
+		"nb": "Kypros",
+		"fi": "Kypros",
+		"ru": "Кипр",
+		"tr": "Kıbrıs",
+		"el": "Κύπρος",
+		"ja": "キプロス",
+		"zh": "塞浦路斯",
+		"ko": "키프로스",
+		"ar": "قبرص",
+	},
+	"CZ": {
+		"en": "Czechia",
+		"de": "Tschechien",
+		"fr": "Tchéquie",
+		"es": "Chequia",
+		"it": "Cechia",
+		"nl": "Tsjechië",
+		"pt": "Chéquia",
+		"pl": "Czechy",
+		"cs": "Česko",
+		"sv": "Tjeckien",
+		"da": "Tjekkiet",
+		"nb": "Tsjekkia",
+		"ru": "Чехия",
+		"tr": "Çekya",
+		"el": "Τσεχία",
+		"zh": "捷克",
+		"ko": "체코",
+		"ar": "التشيك",
+	},
+	"DE": {
+		"en": "Germany",
+		"de": "Deutschland",
+		"fr": "Allemagne",
+		"es": "Alemania",
+		"it": "Germania",
+		"nl": "Duitsland",
+		"pt": "Alemanha",
+		"pl": "Niemcy",
+		"cs": "Německo",
+		"sv": "Tyskland",
+		"da": "Tyskland",
+		"nb": "Tyskland",
+		"fi": "Saksa",
+		"ru": "Германия",
+		"tr": "Almanya",
+		"el": "Γερμανία",
+		"ja": "ドイツ",
+		"zh": "德国",
+		"ko": "독일",
+		"ar": "ألمانيا",
+	},
+	"DJ": {
+		"en": "Djibouti",
+		"de": "Dschibuti",
+		"es": "Yibuti",
+		"it": "Gibuti",
+		"pl": "Dżibuti",
+		"cs": "Džibutsko",
+		"ru": "Джибути",
+		"tr": "Cibuti",
+		"el": "Τζιμπουτί",
+		"ja": "ジブチ",
+		"zh": "吉布提",
+		"ko": "지부티",
+		"ar": "جيبوتي",
+	},
+	"DK": {
+		"en": "Denmark",
+		"de": "Dänemark",
+		"fr": "Danemark",
+		"es": "Dinamarca",
+		"it": "Danimarca",
+		"nl": "Denemarken",
+		"pt": "Dinamarca",
+		"pl": "Dania",
+		"cs": "Dánsko",
+		"sv": "Danmark",
+		"da": "Danmark",
+		"nb": "Danmark",
+		"fi": "Tanska",
+		"ru": "Дания",
+		"tr": "Danimarka",
+		"el": "Δανία",
+		"ja": "デンマーク",
+		"zh": "丹麦",
+		"ko": "덴마크",
+		"ar": "الدّنمارك",
+	},
+	"DM": {
+		"en": "Dominica",
+		"fr": "Dominique",
+		"pl": "Dominika",
+		"cs": "Dominika",
+		"ru": "Доминика",
+		"tr": "Dominika",
+		"el": "Ντομίνικα",
+		"ja": "ドミニカ",
+		"zh": "多米尼克",
+		"ko": "도미니카 연방",
+		"ar": "دومينيكا",
+	},
+	"DO": {
+		"en": "Dominican Republic",
+		"de": "Dominikanische Republik",
+		"fr": "République dominicaine",
+		"es": "República Dominicana",
+		"it": "Repubblica Dominicana",
+		"nl": "Dominicaanse Republiek",
+		"pt": "República Dominicana",
+		"pl": "Republika Dominikańska",
+		"cs": "Dominikánská republika",
+		"sv": "Dominikanska republiken",
+		"da": "Dominikanske Republik",
+		"nb": "Den dominikanske republikk",
+		"fi": "Dominikaaninen tasavalta",
+		"ru": "Доминиканская республика",
+		"tr": "Dominik Cumhuriyeti",
+		"el": "Δομινικανή Δημοκρατία",
+		"ja": "ドミニカ共和国",
+		"zh": "多米尼加共和国",
+		"ko": "도미니카 공화국",
+		"ar": "جمهوريّة الدّومينيكان",
+	},
+	"DZ": {
+		"en": "Algeria",
+		"de": "Algerien",
+		"fr": "Algérie",
+		"nl": "Algerije",
+		"pt": "Argélia",
+		"pl": "Algieria",
+		"cs": "Alžírsko",
+		"sv": "Algeriet",
+		"da": "Algeriet",
+		"nb": "Algerie",
+		"ru": "Алжир",
+		"tr": "Cezayir",
+		"el": "Αλγερία",
+		"ja": "アルジェリア",
+		"zh": "阿尔及利亚",
+		"ko": "알제리",
+		"ar": "الجزائر",
+	},
+	"EC": {
+		"en": "Ecuador",
+		"fr": "Équateur",
+		"pt": "Equador",
+		"pl": "Ekwador",
+		"cs": "Ekvádor",
+		"ru": "Эквадор",
+		"tr": "Ekvador",
+		"el": "Ισημερινός",
+		"ja": "エクアドル",
+		"zh": "厄瓜多尔",
+		"ko": "에콰도르",
+		"ar": "الإكوادور",
+	},
+	"EE": {
+		"en": "Estonia",
+		"de": "Estland",
+		"fr": "Estonie",
+		"nl": "Estland",
+		"pt": "Estónia",
+		"cs": "Estonsko",
+		"sv": "Estland",
+		"da": "Estland",
+		"nb": "Estland",
+		"fi": "Viro",
+		"ru": "Эстония",
+		"tr": "Estonya",
+		"el": "Εσθονία",
+		"ja": "エストニア",
+		"zh": "爱沙尼亚",
+		"ko": "에스토니아",
+		"ar": "إستونيا",
+	},
+	"EG": {
+		"en": "Egypt",
+		"de": "Ägypten",
+		"fr": "Égypte",
+		"es": "Egipto",
+		"it": "Egitto",
+		"nl": "Egypte",
+		"pt": "Egito",
+		"pl": "Egipt",
+		"sv": "Egypten",
+		"da": "Egypten",
+		"fi": "Egypti",
+		"ru": "Египет",
+		"tr": "Mısır",
+		"el": "Αίγυπτος",
+		"ja": "エジプト",
+		"zh": "埃及",
+		"ko": "이집트",
+		"ar": "مصر",
+	},
+	"EH": {
+		"en": "Western Sahara",
+		"de": "Westsahara",
+		"fr": "Sahara occidental",
+		"es": "Sahara Occidental",
+		"it": "Sahara occidentale",
+		"nl": "Westelijke Sahara",
+		"pt": "Saara Ocidental",
+		"pl": "Sahara Zachodnia",
+		"cs": "Západní Sahara",
+		"sv": "Västsahara",
+		"da": "Vestsahara",
+		"nb": "Vest-Sahara",
+		"fi": "Länsi-Sahara",
+		"ru": "Западная Сахара",
+		"tr": "Batı Sahra",
+		"el": "Δυτική Σαχάρα",
+		"ja": "西サハラ",
+		"zh": "西撒哈拉",
+		"ko": "서사하라",
+		"ar": "الصّحراء الغربيّة",
+	},
+	"ER": {
+		"en": "Eritrea",
+		"fr": "Érythrée",
+		"pt": "Eritreia",
+		"pl": "Erytrea",
+		"ru": "Эритрея",
+		"tr": "Eritre",
+		"el": "Ερυθραία",
+		"ja": "エリトリア国",
+		"zh": "厄立特里亚",
+		"ko": "에리트레아",
+		"ar": "إريتريا",
+	},
+	"ES": {
+		"en": "Spain",
+		"de": "Spanien",
+		"fr": "Espagne",
+		"es": "España",
+		"it": "Spagna",
+		"nl": "Spanje",
+		"pt": "Espanha",
+		"pl": "Hiszpania",
+		"cs": "Španělsko",
+		"sv": "Spanien",
+		"da": "Spanien",
+		"nb": "Spania",
+		"fi": "Espanja",
+		"ru": "Испания",
+		"tr": "İspanya",
+		"el": "Ισπανία",
+		"ja": "スペイン",
+		"zh": "西班牙",
+		"ko": "스페인",
+		"ar": "إسبانيا",
+	},
+	"ET": {
+		"en": "Ethiopia",
+		"de": "Äthiopien",
+		"fr": "Éthiopie",
+		"es": "Etiopía",
+		"it": "Etiopia",
+		"nl": "Ethiopië",
+		"pt": "Etiópia",
+		"pl": "Etiopia",
+		"cs": "Etiopie",
+		"sv": "Etiopien",
+		"da": "Etiopien",
+		"nb": "Etiopia",
+		"fi": "Etiopia",
+		"ru": "Эфиопия",
+		"tr": "Etiyopya",
+		"el": "Αιθιοπία",
+		"ja": "エチオピア",
+		"zh": "埃塞俄比亚",
+		"ko": "에티오피아",
+		"ar": "إثيوبيا",
+	},
+	"FI": {
+		"en": "Finland",
+		"de": "Finnland",
+		"fr": "Finlande",
+		"es": "Finlandia",
+		"it": "Finlandia",
+		"pt": "Finlândia",
+		"pl": "Finlandia",
+		"cs": "Finsko",
+		"fi": "Suomi",
+		"ru": "Финляндия",
+		"tr": "Finlandiya",
+		"el": "Φινλανδία",
+		"ja": "フィンランド",
+		"zh": "芬兰",
+		"ko": "핀란드",
+		"ar": "فنلندا",
+	},
+	"FJ": {
+		"en": "Fiji",
+		"de": "Fidschi",
+		"fr": "Fidji",
+		"es": "Fiyi",
+		"it": "Figi",
+		"pl": "Fidżi",
+		"cs": "Fidži",
+		"fi": "Fidži",
+		"ru": "Фиджи",
+		"el": "Φίτζι",
+		"ja": "フィジー",
+		"zh": "斐济",
+		"ko": "피지",
+		"ar": "فيجي",
+	},
+	"FK": {
+		"en": "Falkland Islands",
+		"de": "Falklandinseln (Malwinen)",
+		"es": "Islas Falkland (Malvinas)",
+		"it": "Isole Falkland (Malvine)",
+		"nl": "Falklandeilanden (Malvinas)",
+		"pt": "Ilhas Falkland (Malvinas)",
+		"pl": "Falklandy (Malwiny)",
+		"cs": "Falkandské ostrovy (Malvíny)",
+		"sv": "Falklandsöarna (Malvinas)",
+		"da": "Falklandsøerne (Malvinas)",
+		"nb": "Falklandsøyene",
+		"fi": "Falklandinsaaret",
+		"ru": "Фолклендские (Мальвинские) острова",
+		"tr": "Falkland Adaları (Malvinas)",
+		"el": "Νήσοι Φώκλαντ (Μαλβίνες)",
+		"ja": "フォークランド諸島 (マルビナス)",
+		"zh": "福克兰群岛(马尔维纳斯)",
+		"ko": "포클랜드 제도 (말비나스)",
+		"ar": "جزر فولكلاند (مالفيناس)",
+	},
+	"FM": {
+		"en": "Micronesia",
+		"pl": "Mikronezja",
+		"da": "Mikronesiens Forenede Stater",
+		"fi": "Mikronesian liittovaltio",
+		"ru": "Федеративные Штаты Микронезии",
+		"tr": "Mikronezya Federe Devletleri",
+		"ja": "ミクロネシア連邦",
+		"zh": "密克罗尼西亚",
+		"ko": "미크로네시아 연방",
+		"ar": "ميكرونيزيا، ولايات ميكرونيزيا الموحّدة",
+	},
+	"FO": {
+		"en": "Faroe Islands",
+		"de": "Färöer-Inseln",
+		"fr": "îles Féroé",
+		"es": "Islas Feroe",
+		"it": "Isole Fær Øer",
+		"nl": "Faeröer",
+		"pt": "Ilhas Faroé",
+		"pl": "Wyspy Owcze",
+		"cs": "Faerské ostrovy",
+		"sv": "Färöarna",
+		"da": "Færøerne",
+		"nb": "Færøyene",
+		"fi": "Färsaaret",
+		"ru": "Фарерские острова",
+		"tr": "Faroe Adaları",
+		"el": "Νησιά Φερόε",
+		"ja": "フェロー諸島",
+		"zh": "法罗群岛",
+		"ko": "페로 제도",
+		"ar": "جزر الفارو",
+	},
+	"FR": {
+		"en": "France",
+		"de": "Frankreich",
+		"es": "Francia",
+		"it": "Francia",
+		"nl": "Frankrijk",
+		"pt": "França",
+		"pl": "Francja",
+		"cs": "Francie",
+		"sv": "Frankrike",
+		"da": "Frankrig",
+		"nb": "Frankrike",
+		"fi": "Ranska",
+		"ru": "Франция",
+		"tr": "Fransa",
+		"el": "Γαλλία",
+		"ja": "フランス",
+		"zh": "法国",
+		"ko": "프랑스",
+		"ar": "فرنسا",
+	},
+	"GA": {
+		"en": "Gabon",
+		"de": "Gabun",
+		"es": "Gabón",
+		"pt": "Gabão",
+		"ru": "Габон",
+		"el": "Γκαμπόν",
+		"ja": "ガボン",
+		"zh": "加蓬",
+		"ko": "가봉",
+		"ar": "الغابون",
+	},
+	"GB": {
+		"en": "United Kingdom",
+		"de": "Vereinigtes Königreich",
+		"fr": "Royaume-Uni",
+		"es": "Reino Unido",
+		"it": "Regno Unito",
+		"nl": "Verenigd Koninkrijk",
+		"pt": "Reino Unido",
+		"pl": "Wielka Brytania",
+		"cs": "Spojené království",
+		"sv": "Förenade kungariket",
+		"da": "Storbritannien",
+		"nb": "Storbritannia",
+		"fi": "Yhdistynyt kuningaskunta",
+		"ru": "Соединённое Королевство",
+		"tr": "Birleşik Krallık",
+		"el": "Ηνωμένο Βασίλειο",
+		"ja": "英国",
+		"zh": "英国",
+		"ko": "영국",
+		"ar": "المملكة المتّحدة",
+	},
+	"GD": {
+		"en": "Grenada",
+		"fr": "Grenade",
+		"es": "Granada",
+		"pt": "Granada",
+		"ru": "Гренада",
+		"el": "Γρενάδα",
+		"ja": "グレナダ",
+		"zh": "格林纳达",
+		"ko": "그레나다",
+		"ar": "غرينادا",
+	},
+	"GE": {
+		"en": "Georgia",
+		"de": "Georgien",
+		"fr": "Géorgie",
+		"pt": "Geórgia",
+		"pl": "Gruzja",
+		"cs": "Gruzie",
+		"sv": "Georgien",
+		"da": "Georgien",
+		"ru": "Грузия",
+		"tr": "Gürcistan",
+		"el": "Γεωργία",
+		"ja": "グルジア",
+		"zh": "格鲁吉亚",
+		"ko": "조지아",
+		"ar": "جورجيا",
+	},
+	"GF": {
+		"en": "French Guiana",
+		"de": "Französisch-Guyana",
+		"fr": "Guyane française",
+		"es": "Guayana Francesa",
+		"it": "Guyana francese",
+		"nl": "Frans-Guyana",
+		"pt": "Guiana Francesa",
+		"pl": "Gujana Francuska",
+		"cs": "Francouzská Guayana",
+		"sv": "Franska Guyana",
+		"da": "Fransk Guyana",
+		"nb": "Fransk Guyana",
+		"fi": "Ranskan Guayana",
+		"ru": "Французская Гвиана",
+		"tr": "Fransız Guyanası",
+		"el": "Γαλλική Γουιάνα",
+		"ja": "仏領ギアナ",
+		"zh": "法属圭亚那",
+		"ko": "프랑스령 기아나",
+		"ar": "غيانا الفرنسيّة",
+	},
+	"GG": {
+		"en": "Guernsey",
+		"fr": "Guernesey",
+		"ru": "Гернси",
+		"el": "Γκέρνσεϊ",
+		"ja": "ガーンジー",
+		"zh": "根西岛",
+		"ko": "건지 섬",
+		"ar": "جزيرة جويرزني",
+	},
+	"GH": {
+		"en": "Ghana",
+		"pt": "Gana",
+		"ru": "Гана",
+		"tr": "Gana",
+		"el": "Γκάνα",
+		"ja": "ガーナ",
+		"zh": "加纳",
+		"ko": "가나",
+		"ar": "غانا",
+	},
+	"GI": {
+		"en": "Gibraltar",
+		"it": "Gibilterra",
+		"ru": "Гибралтар",
+		"tr": "Cebelitarık",
+		"el": "Γιβραλτάρ",
+		"ja": "ジブラルタル",
+		"zh": "直布罗陀",
+		"ko": "지브롤터",
+		"ar": "جبل طارق",
+	},
+	"GL": {
+		"en": "Greenland",
+		"de": "Grönland",
+		"fr": "Groënland",
+		"es": "Groenlandia",
+		"it": "Groenlandia",
+		"nl": "Groenland",
+		"pt": "Gronelândia",
+		"pl": "Grenlandia",
+		"cs": "Grónsko",
+		"sv": "Grönland",
+		"da": "Grønland",
+		"nb": "Grønland",
+		"fi": "Grönlanti",
+		"ru": "Гренландия",
+		"tr": "Grönland",
+		"el": "Γροιλανδία",
+		"ja": "グリーンランド",
+		"zh": "格陵兰",
+		"ko": "그린란드",
+		"ar": "غرينلاند",
+	},
+	"GM": {
+		"en": "Gambia",
+		"fr": "Gambie",
+		"pt": "Gâmbia",
+		"cs": "Gambie",
+		"ru": "Гамбия",
+		"tr": "Gambiya",
+		"el": "Γκάμπια",
+		"ja": "ガンビア",
+		"zh": "冈比亚",
+		"ko": "감비아",
+		"ar": "غامبيا",
+	},
+	"GN": {
+		"en": "Guinea",
+		"fr": "Guinée",
+		"nl": "Guinee",
+		"pt": "Guiné",
+		"pl": "Gwinea",
+		"ru": "Гвинея",
+		"tr": "Gine",
+		"el": "Γουινέα",
+		"ja": "ギニア",
+		"zh": "几内亚",
+		"ko": "기니",
+		"ar": "غينيا",
+	},
+	"GP": {
+		"en": "Guadeloupe",
+		"es": "Guadalupe",
+		"it": "Guadalupa",
+		"pt": "Guadalupe",
+		"pl": "Gwadelupa",
+		"ru": "Гваделупа",
+		"el": "Γουαδελούπη",
+		"ja": "グアドループ",
+		"zh": "瓜德罗普",
+		"ko": "과들루프",
+		"ar": "جوادالوبّي",
+	},
+	"GQ": {
+		"en": "Equatorial Guinea",
+		"de": "Äquatorialguinea",
+		"fr": "Guinée Équatoriale",
+		"es": "Guinea Ecuatorial",
+		"it": "Guinea equatoriale",
+		"nl": "Equatoriaal-Guinea",
+		"pt": "Guiné Equatorial",
+		"pl": "Gwinea Równikowa",
+		"cs": "Rovníková Guinea",
+		"sv": "Ekvatorialguinea",
+		"da": "Ækvatorialguinea",
+		"nb": "Ekvatorial-Guinea",
+		"fi": "Päiväntasaajan Guinea",
+		"ru": "Экваториальная Гвинея",
+		"tr": "Ekvator Ginesi",
+		"el": "Ισημερινή Γουινέα",
+		"ja": "赤道ギニア",
+		"zh": "赤道几内亚",
+		"ko": "적도 기니",
+		"ar": "غينيا الاستوائيّة",
+	},
+	"GR": {
+		"en": "Greece",
+		"de": "Griechenland",
+		"fr": "Grèce",
+		"es": "Grecia",
+		"it": "Grecia",
+		"nl": "Griekenland",
+		"pt": "Grécia",
+		"pl": "Grecja",
+		"cs": "Řecko",
+		"sv": "Grekland",
+		"da": "Grækenland",
+		"nb": "Hellas",
+		"fi": "Kreikka",
+		"ru": "Греция",
+		"tr": "Yunanistan",
+		"el": "Ελλάδα",
+		"ja": "ギリシャ",
+		"zh": "希腊",
+		"ko": "그리스",
+		"ar": "اليونان",
+	},
+	"GS": {
+		"en": "South Georgia and the South Sandwich Islands",
+		"de": "South Georgia und die Südlichen Sandwichinseln",
+		"fr": "Géorgie du Sud et les îles Sandwich du Sud",
+		"es": "Islas Georgias del Sur y Sándwich del Sur",
+		"it": "Georgia del Sud e Isole Sandwich Australi",
+		"nl": "Zuid-Georgia en de Zuidelijke Sandwicheilanden",
+		"pt": "Ilhas Geórgia do Sul e Sandwich do Sul",
+		"pl": "Georgia Południowa i Sandwich Południowy",
+		"cs": "Jižní Georgie a Jižní Sandwichovy ostrovy",
+		"sv": "Sydgeorgien och södra Sandwichöarna",
+		"da": "South Georgia og De Sydlige Sandwichøer",
+		"nb": "Sør-Georgia og Sør-Sandwichøyene",
+		"fi": "Etelä-Georgia ja Eteläiset Sandwichinsaaret",
+		"ru": "Южная Джорджия и Южные Сандвичевы острова",
+		"tr": "Güney Georgia ve Güney Sandwich Adaları",
+		"el": "Νήσοι Νότια Γεωργία και Νότιες Σάντουιτς",
+		"ja": "サウスジョージア及びサウスサンドウィッチ諸島",
+		"zh": "南乔治亚岛和南桑德韦奇岛",
+		"ko": "사우스조지아 사우스샌드위치 제도",
+		"ar": "جورجيا الجنوبيّة و جزر ساندويتش الجنوبيّة",
+	},
+	"GT": {
+		"en": "Guatemala",
+		"pl": "Gwatemala",
+		"ru": "Гватемала",
+		"el": "Γουατεμάλα",
+		"ja": "グアテマラ",
+		"zh": "瓜地马拉",
+		"ko": "과테말라",
+		"ar": "غواتيمالا",
+	},
+	"GU": {
+		"en": "Guam",
+		"ru": "Гуам",
+		"el": "Γκουάμ",
+		"ja": "グアム",
+		"zh": "关岛",
+		"ko": "괌",
+		"ar": "جوام",
+	},
+	"GW": {
+		"en": "Guinea-Bissau",
+		"fr": "Guinée-Bissau",
+		"es": "Guinea-Bisáu",
+		"nl": "Guinee-Bissau",
+		"pt": "Guiné-Bissáu",
+		"pl": "Gwinea Bissau",
+		"ru": "Гвинея-Бисау",
+		"tr": "Gine-Bissau",
+		"el": "Γουινέα-Μπισσάου",
+		"ja": "ギニアビサウ",
+		"zh": "几内亚比绍",
+		"ko": "기니비사우",
+		"ar": "غينيا بيساو",
+	},
+	"GY": {
+		"en": "Guyana",
+		"pt": "Guiana",
+		"pl": "Gujana",
+		"ru": "Гайана",
+		"el": "Γουιάνα",
+		"ja": "ガイアナ",
+		"zh": "圭亚那",
+		"ko": "가이아나",
+		"ar": "غويانا",
+	},
+	"HK": {
+		"en": "Hong Kong",
+		"de": "Hongkong",
+		"nl": "Hongkong",
+		"pl": "Hongkong",
+		"cs": "Hongkong",
+		"sv": "Hongkong",
+		"da": "Hongkong",
+		"nb": "Hongkong",
+		"ru": "Гонконг",
+		"el": "Χονγκ Κονγκ",
+		"ja": "香港",
+		"zh": "香港",
+		"ko": "홍콩",
+		"ar": "هونغ كونغ",
+	},
+	"HM": {
+		"en": "Heard Island and McDonald Islands",
+		"de": "Heard und McDonaldinseln",
+		"fr": "îles Heard-et-MacDonald",
+		"es": "Islas Heard y McDonald",
+		"it": "Isole Heard e McDonald",
+		"nl": "Heardeiland en McDonaldeilanden",
+		"pt": "Ilha Heard e Ilhas McDonald",
+		"pl": "Wyspy Heard i McDonalda",
+		"cs": "Heardův a McDonaldovy ostrovy",
+		"sv": "Heardön och McDonaldöarna",
+		"da": "Heard-øen og McDonald-øerne",
+		"nb": "Heard- og McDonaldøyene",
+		"fi": "Heard ja McDonaldinsaaret",
+		"ru": "Остров Херд и острова МакДональд",
+		"tr": "Heard Adası ve McDonald Adaları",
+		"el": "Νήσος Χερντ και Νήσοι ΜακΝτόναλντ",
+		"ja": "ハード島及びマクドナルド諸島",
+		"zh": "赫德岛与麦克唐纳群岛",
+		"ko": "허드 맥도널드 제도",
+		"ar": "جزيرة هيرد وجزر مَكْدونالد",
+	},
+	"HN": {
+		"en": "Honduras",
+		"ru": "Гондурас",
+		"el": "Ονδούρα",
+		"ja": "ホンジュラス",
+		"zh": "洪都拉斯",
+		"ko": "온두라스",
+		"ar": "هندوراس",
+	},
+	"HR": {
+		"en": "Croatia",
+		"de": "Kroatien",
+		"fr": "Croatie",
+		"es": "Croacia",
+		"it": "Croazia",
+		"nl": "Kroatië",
+		"pt": "Croácia",
+		"pl": "Chorwacja",
+		"cs": "Chorvatsko",
+		"sv": "Kroatien",
+		"da": "Kroatien",
+		"nb": "Kroatia",
+		"fi": "Kroatia",
+		"ru": "Хорватия",
+		"tr": "Hırvatistan",
+		"el": "Κροατία",
+		"ja": "クロアチア",
+		"zh": "克罗地亚",
+		"ko": "크로아티아",
+		"ar": "كرواتيا",
+	},
+	"HT": {
+		"en": "Haiti",
+		"fr": "Haïti",
+		"es": "Haití",
+		"nl": "Haïti",
+		"ru": "Гаити",
+		"el": "Αϊτή",
+		"ja": "ハイチ",
+		"zh": "海地",
+		"ko": "아이티",
+		"ar": "هايتي",
+	},
+	"HU": {
+		"en": "Hungary",
+		"de": "Ungarn",
+		"fr": "Hongrie",
+		"es": "Hungría",
+		"it": "Ungheria",
+		"nl": "Hongarije",
+		"pt": "Hungria",
+		"pl": "Węgry",
+		"cs": "Maďarsko",
+		"sv": "Ungern",
+		"da": "Ungarn",
+		"nb": "Ungarn",
+		"fi": "Unkari",
+		"ru": "Венгрия",
+		"tr": "Macaristan",
+		"el": "Ουγγαρία",
+		"ja": "ハンガリー",
+		"zh": "匈牙利",
+		"ko": "헝가리",
+		"ar": "المجر (هنغاريا)",
+	},
+	"ID": {
+		"en": "Indonesia",
+		"de": "Indonesien",
+		"fr": "Indonésie",
+		"nl": "Indonesië",
+		"pt": "Indonésia",
+		"pl": "Indonezja",
+		"cs": "Indonésie",
+		"sv": "Indonesien",
+		"da": "Indonesien",
+		"ru": "Индонезия",
+		"tr": "Endonezya",
+		"el": "Ινδονησία",
+		"ja": "インドネシア",
+		"zh": "印度尼西亚",
+		"ko": "인도네시아",
+		"ar": "إندونيسيا",
+	},
+	"IE": {
+		"en": "Ireland",
+		"de": "Irland",
+		"fr": "Irlande",
+		"es": "Irlanda",
+		"it": "Irlanda",
+		"nl": "Ierland",
+		"pt": "Irlanda",
+		"pl": "Irlandia",
+		"cs": "Irsko",
+		"sv": "Irland",
+		"da": "Irland",
+		"nb": "Irland",
+		"fi": "Irlanti",
+		"ru": "Ирландия",
+		"tr": "İrlanda",
+		"el": "Ιρλανδία",
+		"ja": "アイルランド",
+		"zh": "爱尔兰",
+		"ko": "아일랜드",
+		"ar": "أيرلندا",
+	},
+	"IL": {
+		"en": "Israel",
+		"fr": "Israël",
+		"it": "Israele",
+		"nl": "Israël",
+		"pl": "Izrael",
+		"cs": "Izrael",
+		"ru": "Израиль",
+		"tr": "İsrail",
+		"el": "Ισραήλ",
+		"ja": "イスラエル",
+		"zh": "以色列",
+		"ko": "이스라엘",
+		"ar": "إسرائيل",
+	},
+	"IM": {
+		"en": "Isle of Man",
+		"de": "Insel Man",
+		"fr": "Île de Man",
+		"es": "Isla de Man",
+		"it": "Isola di Man",
+		"nl": "Eiland Man",
+		"pt": "Ilha de Man",
+		"pl": "Wyspa Man",
+		"cs": "Ostrov Man",
+		"nb": "Man",
+		"fi": "Mansaari",
+		"ru": "Остров Мэн",
+		"tr": "Man Adası",
+		"el": "Νήσος του Μαν",
+		"ja": "マン島",
+		"zh": "曼岛",
+		"ko": "맨 섬",
+		"ar": "آيزل أف مان",
+	},
+	"IN": {
+		"en": "India",
+		"de": "Indien",
+		"fr": "Inde",
+		"pt": "Índia",
+		"pl": "Indie",
+		"cs": "Indie",
+		"sv": "Indien",
+		"da": "Indien",
+		"fi": "Intia",
+		"ru": "Индия",
+		"tr": "Hindistan",
+		"el": "Ινδία",
+		"ja": "インド",
+		"zh": "印度",
+		"ko": "인도",
+		"ar": "الهند",
+	},
+	"IO": {
+		"en": "British Indian Ocean Territory",
+		"de": "Britisches Territorium im Indischen Ozean",
+		"fr": "Territoire britannique de l'océan Indien",
+		"es": "Territorio Británico del Océano Índico",
+		"it": "Territorio britannico dell'Oceano Indiano",
+		"nl": "Brits Indische Oceaanterritorium",
+		"pt": "Território Britânico do Oceano Índico",
+		"pl": "Brytyjskie Terytorium Oceanu Indyjskiego",
+		"cs": "Britské indickooceánské území",
+		"sv": "Brittiskt territorium i Indiska Oceanen",
+		"da": "Det britiske territorium i Det Indiske Ocean",
+		"nb": "Det britiske territoriet i Indiahavet",
+		"fi": "Brittiläinen Intian valtameren alue",
+		"ru": "Британская территория Индийского океана",
+		"tr": "Britanya Hint Okyanusu Toprakları",
+		"el": "Βρετανικό Έδαφος Ινδικού Ωκεανού",
+		"ja": "英国インド洋領土",
+		"zh": "英属印度洋领地",
+		"ko": "영국령 인도양 지역",
+		"ar": "مقاطعة المحيط الهندي البريطانيّة",
+	},
+	"IQ": {
+		"en": "Iraq",
+		"de": "Irak",
+		"fr": "Irak",
+		"es": "Irak",
+		"nl": "Irak",
+		"pt": "Iraque",
+		"pl": "Irak",
+		"cs": "Irák",
+		"sv": "Irak",
+		"da": "Irak",
+		"nb": "Irak",
+		"fi": "Irak",
+		"ru": "Ирак",
+		"tr": "Irak",
+		"el": "Ιράκ",
+		"ja": "イラク",
+		"zh": "伊拉克",
+		"ko": "이라크",
+		"ar": "العراق",
+	},
+	"IR": {
+		"en": "Iran",
+		"cs": "Írán",
+		"tr": "İran",
+		"zh": "伊朗",
+	},
+	"IS": {
+		"en": "Iceland",
+		"de": "Island",
+		"fr": "Islande",
+		"es": "Islandia",
+		"it": "Islanda",
+		"nl": "IJsland",
+		"pt": "Islândia",
+		"pl": "Islandia",
+		"cs": "Island",
+		"sv": "Island",
+		"da": "Island",
+		"nb": "Island",
+		"fi": "Islanti",
+		"ru": "Исландия",
+		"tr": "İzlanda",
+		"el": "Ισλανδία",
+		"ja": "アイスランド",
+		"zh": "冰岛",
+		"ko": "아이슬란드",
+		"ar": "آيسلندا",
+	},
+	"IT": {
+		"en": "Italy",
+		"de": "Italien",
+		"fr": "Italie",
+		"es": "Italia",
+		"it": "Italia",
+		"nl": "Italië",
+		"pt": "Itália",
+		"pl": "Włochy",
+		"cs": "Itálie",
+		"sv": "Italien",
+		"da": "Italien",
+		"nb": "Italia",
+		"fi": "Italia",
+		"ru": "Италия",
+		"tr": "İtalya",
+		"el": "Ιταλία",
+		"ja": "イタリア",
+		"zh": "意大利",
+		"ko": "이탈리아",
+		"ar": "إيطاليا",
+	},
+	"JE": {
+		"en": "Jersey",
+		"ru": "Джерси",
+		"el": "Τζέρσεϊ",
+		"ja": "ジャージー",
+		"zh": "泽西岛",
+		"ko": "저지 섬",
+		"ar": "جيرسي",
+	},
+	"JM": {
+		"en": "Jamaica",
+		"de": "Jamaika",
+		"fr": "Jamaïque",
+		"it": "Giamaica",
+		"pl": "Jamajka",
+		"cs": "Jamajka",
+		"fi": "Jamaika",
+		"ru": "Ямайка",
+		"tr": "Jamaika",
+		"el": "Τζαμάικα",
+		"ja": "ジャマイカ",
+		"zh": "牙买加",
+		"ko": "자메이카",
+		"ar": "جامايكا",
+	},
+	"JO": {
+		"en": "Jordan",
+		"de": "Jordanien",
+		"fr": "Jordanie",
+		"es": "Jordania",
+		"it": "Giordania",
+		"nl": "Jordanië",
+		"pt": "Jordânia",
+		"pl": "Jordania",
+		"cs": "Jordánsko",
+		"sv": "Jordanien",
+		"fi": "Jordania",
+		"ru": "Иордания",
+		"tr": "Ürdün",
+		"el": "Ιορδανία",
+		"ja": "ヨルダン",
+		"zh": "约旦",
+		"ko": "요르단",
+		"ar": "الأردن",
+	},
+	"JP": {
+		"en": "Japan",
+		"fr": "Japon",
+		"es": "Japón",
+		"it": "Giappone",
+		"pt": "Japão",
+		"pl": "Japonia",
+		"cs": "Japonsko",
+		"fi": "Japani",
+		"ru": "Япония",
+		"tr": "Japonya",
+		"el": "Ιαπωνία",
+		"ja": "日本",
+		"zh": "日本",
+		"ko": "일본",
+		"ar": "اليابان",
+	},
+	"KE": {
+		"en": "Kenya",
+		"de": "Kenia",
+		"es": "Kenia",
+		"nl": "Kenia",
+		"pt": "Quénia",
+		"pl": "Kenia",
+		"cs": "Keňa",
+		"fi": "Kenia",
+		"ru": "Кения",
+		"el": "Κένυα",
+		"ja": "ケニア",
+		"zh": "肯尼亚",
+		"ko": "케냐",
+		"ar": "كينيا",
+	},
+	"KG": {
+		"en": "Kyrgyzstan",
+		"de": "Kirgisistan",
+		"fr": "Kirghizistan",
+		"es": "Kirguistán",
+		"it": "Kirghizistan",
+		"nl": "Kirgizië",
+		"pt": "Quirguistão",
+		"pl": "Kirgistan",
+		"cs": "Kyrgyzstán",
+		"sv": "Kirgizistan",
+		"da": "Kirgisistan",
+		"nb": "Kirgisistan",
+		"fi": "Kirgisia",
+		"ru": "Киргизия",
+		"tr": "Kırgızistan",
+		"el": "Κιργιζία",
+		"ja": "キルギスタン",
+		"zh": "吉尔吉斯坦",
+		"ko": "키르기스스탄",
+		"ar": "قيرغزستان",
+	},
+	"KH": {
+		"en": "Cambodia",
+		"de": "Kambodscha",
+		"fr": "Cambodge",
+		"es": "Camboya",
+		"it": "Cambogia",
+		"nl": "Cambodja",
+		"pt": "Camboja",
+		"pl": "Kambodża",
+		"cs": "Kambodža",
+		"sv": "Kambodja",
+		"da": "Cambodja",
+		"nb": "Kambodsja",
+		"fi": "Kambodža",
+		"ru": "Камбоджа",
+		"tr": "Kamboçya",
+		"el": "Καμπότζη",
+		"ja": "カンボジア",
+		"zh": "柬埔塞",
+		"ko": "캄보디아",
+		"ar": "كمبوديا",
+	},
+	"KI": {
+		"en": "Kiribati",
+		"ru": "Кирибати",
+		"el": "Κιριμπάτι",
+		"ja": "キリバス",
+		"zh": "基里巴斯",
+		"ko": "키리바시",
+		"ar": "كيريباتي",
+	},
+	"KM": {
+		"en": "Comoros",
+		"de": "Komoren",
+		"fr": "Comores",
+		"it": "Comore",
+		"nl": "Comoren",
+		"pt": "Comores",
+		"pl": "Komory",
+		"cs": "Komory",
+		"sv": "Comorerna",
+		"da": "Comorerne",
+		"nb": "Komorene",
+		"fi": "Komorit",
+		"ru": "Коморы",
+		"tr": "Komorlar",
+		"el": "Κομόρες",
+		"ja": "コモロ",
+		"zh": "科摩罗",
+		"ko": "코모로",
+		"ar": "جزر القمر",
+	},
+	"KN": {
+		"en": "Saint Kitts and Nevis",
+		"de": "St. Kitts und Nevis",
+		"fr": "Saint-Christophe-et-Niévès",
+		"es": "San Cristóbal y Nieves",
+		"it": "Saint Kitts e Nevis",
+		"nl": "Saint Kitts en Nevis",
+		"pt": "São Cristóvão e Nevis",
+		"pl": "Saint Kitts i Nevis",
+		"cs": "Svatý Kryštof a Nevis",
+		"sv": "Sankt Kitts och Nevis",
+		"da": "Sankt Kitts og Nevis",
+		"nb": "Saint Kitts og Nevis",
+		"fi": "Saint Kitts ja Nevis",
+		"ru": "Сент-Китс и Невис",
+		"tr": "Saint Kitts ve Nevis",
+		"el": "Άγιος Χριστόφορος και Νέβις",
+		"ja": "セントクリストファー・ネーヴィス",
+		"zh": "圣基茨和尼维斯",
+		"ko": "세인트키츠 네비스",
+		"ar": "سانت كيتس و نيفس",
+	},
+	"KP": {
+		"en": "North Korea",
+		"de": "Nordkorea",
+		"fr": "Corée du Nord",
+		"it": "Corea del Nord",
+		"nl": "Noord-Korea",
+		"pt": "Coreia do Norte",
+		"pl": "Korea Północna",
+		"cs": "Severní Korea",
+		"sv": "Nordkorea",
+		"nb": "Nord-Korea",
+		"ru": "Северная Корея",
+		"tr": "Kuzey Kore",
+		"zh": "朝鲜",
+		"ko": "조선민주주의인민공화국",
+	},
+	"KR": {
+		"en": "South Korea",
+		"de": "Südkorea",
+		"fr": "Corée du Sud",
+		"it": "Corea del Sud",
+		"nl": "Zuid-Korea",
+		"pt": "Coreia do Sul",
+		"pl": "Korea Południowa",
+		"cs": "Jižní Korea",
+		"sv": "Sydkorea",
+		"nb": "Sør-Korea",
+		"ru": "Южная Корея",
+		"tr": "Güney Kore",
+		"zh": "韩国",
+		"ko": "대한민국",
+	},
+	"KW": {
+		"en": "Kuwait",
+		"fr": "Koweït",
+		"nl": "Koeweit",
+		"pl": "Kuwejt",
+		"cs": "Kuvajt",
+		"ru": "Кувейт",
+		"tr": "Kuveyt",
+		"el": "Κουβέιτ",
+		"ja": "クウェート",
+		"zh": "科威特",
+		"ko": "쿠웨이트",
+		"ar": "الكويت",
+	},
+	"KY": {
+		"en": "Cayman Islands",
+		"de": "Cayman-Inseln",
+		"fr": "îles Caïmans",
+		"es": "Islas Caimán",
+		"it": "Isole Cayman",
+		"nl": "Kaaimaneilanden",
+		"pt": "Ilhas Caimão",
+		"pl": "Kajmany",
+		"cs": "Kajmanské ostrovy",
+		"sv": "Caymanöarna",
+		"da": "Caymanøerne",
+		"nb": "Caymanøyene",
+		"fi": "Caymansaaret",
+		"ru": "Каймановы острова",
+		"tr": "Cayman Adaları",
+		"el": "Νησιά Κέιμαν",
+		"ja": "ケイマン諸島",
+		"zh": "开曼群岛",
+		"ko": "케이맨 제도",
+		"ar": "جزر الكيمان",
+	},
+	"KZ": {
+		"en": "Kazakhstan",
+		"de": "Kasachstan",
+		"es": "Kazajistán",
+		"it": "Kazakistan",
+		"nl": "Kazachstan",
+		"pt": "Cazaquistão",
+		"pl": "Kazachstan",
+		"cs": "Kazachstán",
+		"sv": "Kazakstan",
+		"da": "Kasakhstan",
+		"nb": "Kasakhstan",
+		"fi": "Kazakstan",
+		"ru": "Казахстан",
+		"tr": "Kazakistan",
+		"el": "Καζακστάν",
+		"ja": "カザフスタン",
+		"zh": "哈萨克斯坦",
+		"ko": "카자흐스탄",
+		"ar": "كازاخستان",
+	},
+	"LA": {
+		"en": "Laos",
+		"zh": "老挝",
+	},
+	"LB": {
+		"en": "Lebanon",
+		"de": "Libanon",
+		"fr": "Liban",
+		"es": "Líbano",
+		"it": "Libano",
+		"nl": "Libanon",
+		"pt": "Líbano",
+		"pl": "Liban",
+		"cs": "Libanon",
+		"sv": "Libanon",
+		"da": "Libanon",
+		"nb": "Libanon",
+		"fi": "Libanon",
+		"ru": "Ливан",
+		"tr": "Lübnan",
+		"el": "Λίβανος",
+		"ja": "レバノン",
+		"zh": "黎巴嫩",
+		"ko": "레바논",
+		"ar": "لبنان",
+	},
+	"LC": {
+		"en": "Saint Lucia",
+		"de": "St. Lucia",
+		"fr": "Sainte-Lucie",
+		"es": "Santa Lucía",
+		"pt": "Santa Lúcia",
+		"cs": "Svatá Lucie",
+		"sv": "Sankt Lucia",
+		"da": "Sankt Lucia",
+		"ru": "Сент-Люсия",
+		"el": "Αγία Λουκία",
+		"ja": "セントルシア",
+		"zh": "圣路西亚",
+		"ko": "세인트루시아",
+		"ar": "سانت لوسيا",
+	},
+	"LI": {
+		"en": "Liechtenstein",
+		"cs": "Lichtenštejnsko",
+		"ru": "Лихтенштейн",
+		"tr": "Lihtenştayn",
+		"el": "Λίχτενσταϊν",
+		"ja": "リヒテンシュタイン",
+		"zh": "列支敦士登",
+		"ko": "리히텐슈타인",
+		"ar": "ليشتنشتاين",
+	},
+	"LK": {
+		"en": "Sri Lanka",
+		"cs": "Šrí Lanka",
+		"ru": "Шри-Ланка",
+		"el": "Σρι Λάνκα",
+		"ja": "スリランカ",
+		"zh": "斯里兰卡",
+		"ko": "스리랑카",
+		"ar": "سريلانكا",
+	},
+	"LR": {
+		"en": "Liberia",
+		"fr": "Libéria",
+		"pt": "Libéria",
+		"cs": "Libérie",
+		"ru": "Либерия",
+		"tr": "Liberya",
+		"el": "Λιβερία",
+		"ja": "リベリア",
+		"zh": "利比里亚",
+		"ko": "라이베리아",
+		"ar": "ليبيريا",
+	},
+	"LS": {
+		"en": "Lesotho",
+		"es": "Lesoto",
+		"pt": "Lesoto",
+		"ru": "Лесото",
+		"tr": "Lesoto",
+		"el": "Λεσότο",
+		"ja": "レソト",
+		"zh": "莱索托",
+		"ko": "레소토",
+		"ar": "ليسوتو",
+	},
+	"LT": {
+		"en": "Lithuania",
+		"de": "Litauen",
+		"fr": "Lituanie",
+		"es": "Lituania",
+		"it": "Lituania",
+		"nl": "Litouwen",
+		"pt": "Lituânia",
+		"pl": "Litwa",
+		"cs": "Litva",
+		"sv": "Litauen",
+		"da": "Litauen",
+		"nb": "Litauen",
+		"fi": "Liettua",
+		"ru": "Литва",
+		"tr": "Litvanya",
+		"el": "Λιθουανία",
+		"ja": "リトアニア",
+		"zh": "立陶宛",
+		"ko": "리투아니아",
+		"ar": "لثوانيا",
+	},
+	"LU": {
+		"en": "Luxembourg",
+		"de": "Luxemburg",
+		"es": "Luxemburgo",
+		"it": "Lussemburgo",
+		"nl": "Luxemburg",
+		"pt": "Luxemburgo",
+		"pl": "Luksemburg",
+		"cs": "Lucembursko",
+		"sv": "Luxemburg",
+		"fi": "Luxemburg",
+		"ru": "Люксембург",
+		"tr": "Lüksemburg",
+		"el": "Λουξεμβούργο",
+		"ja": "ルクセンブルク",
+		"zh": "卢森堡",
+		"ko": "룩셈부르크",
+		"ar": "لوكسمبورغ",
+	},
+	"LV": {
+		"en": "Latvia",
+		"de": "Lettland",
+		"fr": "Lettonie",
+		"es": "Letonia",
+		"it": "Lettonia",
+		"nl": "Letland",
+		"pt": "Letónia",
+		"pl": "Łotwa",
+		"cs": "Lotyšsko",
+		"sv": "Lettland",
+		"da": "Letland",
+		"ru": "Латвия",
+		"tr": "Letonya",
+		"el": "Λετονία",
+		"ja": "ラトビア",
+		"zh": "拉脱维亚",
+		"ko": "라트비아",
+		"ar": "لاتفيا",
+	},
+	"LY": {
+		"en": "Libya",
+		"de": "Libyen",
+		"fr": "Libye",
+		"es": "Libia",
+		"it": "Libia",
+		"nl": "Libië",
+		"pt": "Líbia",
+		"pl": "Libia",
+		"cs": "Libye",
+		"sv": "Libyen",
+		"da": "Libyen",
+		"ru": "Ливия",
+		"el": "Λιβύη",
+		"ja": "リビア",
+		"zh": "利比亚",
+		"ko": "리비아",
+		"ar": "ليبيا",
+	},
+	"MA": {
+		"en": "Morocco",
+		"de": "Marokko",
+		"fr": "Maroc",
+		"es": "Marruecos",
+		"it": "Marocco",
+		"nl": "Marokko",
+		"pt": "Marrocos",
+		"pl": "Maroko",
+		"cs": "Maroko",
+		"sv": "Marocko",
+		"da": "Marokko",
+		"nb": "Marokko",
+		"fi": "Marokko",
+		"ru": "Марокко",
+		"tr": "Fas",
+		"el": "Μαρόκο",
+		"ja": "モロッコ",
+		"zh": "摩洛哥",
+		"ko": "모로코",
+		"ar": "المغرب",
+	},
+	"MC": {
+		"en": "Monaco",
+		"es": "Mónaco",
+		"pt": "Mónaco",
+		"pl": "Monako",
+		"cs": "Monako",
+		"ru": "Монако",
+		"tr": "Monako",
+		"el": "Μονακό",
+		"ja": "モナコ",
+		"zh": "摩纳哥",
+		"ko": "모나코",
+		"ar": "موناكو",
+	},
+	"MD": {
+		"en": "Moldova",
+		"de": "Moldau",
+		"fr": "Moldavie",
+		"es": "Moldavia",
+		"it": "Moldavia",
+		"nl": "Moldavië",
+		"pt": "Moldávia",
+		"pl": "Mołdawia",
+		"cs": "Moldavsko",
+		"sv": "Moldavien",
+		"ru": "Молдавия",
+		"el": "Μολδαβία",
+		"ja": "モルドバ",
+		"zh": "摩尔多瓦",
+		"ko": "몰도바",
+		"ar": "المالديف",
+	},
+	"ME": {
+		"en": "Montenegro",
+		"fr": "Monténégro",
+		"pl": "Czarnogóra",
+		"cs": "Černá Hora",
+		"ru": "Черногория",
+		"tr": "Karadağ",
+		"el": "Μαυροβούνιο",
+		"ja": "モンテネグロ",
+		"zh": "黑山",
+		"ko": "몬테네그로",
+		"ar": "المنتنيغرو",
+	},
+	"MF": {
+		"en": "Saint Martin",
+		"de": "Saint Martin (Französischer Teil)",
+		"fr": "Saint-Martin (partie française)",
+		"es": "San Martín (zona francesa)",
+		"it": "Saint-Martin (Francia)",
+		"nl": "Sint-Maarten (Frans deel)",
+		"pt": "São Martin (Território Francês)",
+		"pl": "Saint-Martin (część francuska)",
+		"cs": "Svatý Martin (francouzská část)",
+		"sv": "Saint Martin (franska delen)",
+		"da": "Sankt Martin (Fransk del)",
+		"nb": "Saint Martin (fransk del)",
+		"fi": "Saint-Martin (ranskalainen osa)",
+		"ru": "Сен-Мартен (Франция)",
+		"tr": "Saint Martin (Fransız kısmı)",
+		"el": "Άγιος Μαρτίνος (Γαλλικό τμήμα)",
+		"ja": "サンマルタン (仏領)",
+		"zh": "法属圣马丁",
+		"ko": "생마르탱 (프랑스령)",
+		"ar": "سانت مارتين (القطاع الفرنسي)",
+	},
+	"MG": {
+		"en": "Madagascar",
+		"de": "Madagaskar",
+		"nl": "Madagaskar",
+		"pt": "Madagáscar",
+		"pl": "Madagaskar",
+		"cs": "Madagaskar",
+		"sv": "Madagaskar",
+		"da": "Madagaskar",
+		"nb": "Madagaskar",
+		"fi": "Madagaskar",
+		"ru": "Мадагаскар",
+		"tr": "Madagaskar",
+		"el": "Μαδαγασκάρη",
+		"ja": "マダガスカル",
+		"zh": "马达加斯加",
+		"ko": "마다가스카르",
+		"ar": "مدغشقر",
+	},
+	"MH": {
+		"en": "Marshall Islands",
+		"de": "Marshallinseln",
+		"fr": "Îles Marshall",
+		"es": "Islas Marshall",
+		"it": "Isole Marshall",
+		"nl": "Marshalleilanden",
+		"pt": "Ilhas Marshall",
+		"pl": "Wyspy Marshalla",
+		"cs": "Marshallovy ostrovy",
+		"sv": "Marshallöarna",
+		"da": "Marshalløerne",
+		"nb": "Marshalløyene",
+		"fi": "Marshallinsaaret",
+		"ru": "Маршалловы острова",
+		"tr": "Marşal Adaları",
+		"el": "Νήσοι Μάρσαλ",
+		"ja": "マーシャル諸島",
+		"zh": "马绍尔群岛",
+		"ko": "마셜 제도",
+		"ar": "جزر المارشال",
+	},
+	"MK": {
+		"en": "North Macedonia",
+		"de": "Nordmazedonien",
+		"fr": "Macédoine du Nord",
+		"es": "Macedonia del Norte",
+		"it": "Macedonia del Nord",
+		"nl": "Noord-Macedonië",
+		"pt": "Macedónia do Norte",
+		"pl": "Macedonia Północna",
+		"cs": "Severní Makedonie",
+		"sv": "Nordmakedonien",
+		"da": "Nordmakedonien",
+		"nb": "Nord-Makedonia",
+		"ru": "Северная Македония",
+		"tr": "Kuzey Makedonya",
+		"el": "Βόρεια Μακεδονία",
+		"zh": "北马其顿",
+		"ko": "북마케도니아",
+		"ar": "مقدونيا الشمالية",
+	},
+	"ML": {
+		"en": "Mali",
+		"es": "Malí",
+		"ru": "Мали",
+		"el": "Μάλι",
+		"ja": "マリ",
+		"zh": "马里",
+		"ko": "말리",
+		"ar": "مالي",
+	},
+	"MM": {
+		"en": "Myanmar",
+		"fr": "Birmanie",
+		"es": "Birmania",
+		"it": "Birmania",
+		"pt": "Birmânia",
+		"pl": "Mjanma",
+		"da": "Burma",
+		"ru": "Мьянма",
+		"el": "Μιανμάρ",
+		"ja": "ミャンマー",
+		"zh": "缅甸",
+		"ko": "미얀마",
+		"ar": "ميانمار",
+	},
+	"MN": {
+		"en": "Mongolia",
+		"de": "Mongolei",
+		"fr": "Mongolie",
+		"nl": "Mongolië",
+		"pt": "Mongólia",
+		"cs": "Mongolsko",
+		"sv": "Mongoliet",
+		"da": "Mongoliet",
+		"ru": "Монголия",
+		"tr": "Moğolistan",
+		"el": "Μογγολία",
+		"ja": "モンゴル国",
+		"zh": "蒙古",
+		"ko": "몽골",
+		"ar": "منغوليا",
+	},
+	"MO": {
+		"en": "Macao",
+		"fr": "Macau",
+		"nl": "Macau",
+		"pt": "Macau",
+		"pl": "Makau",
+		"ru": "Макао",
+		"tr": "Makao",
+		"el": "Μακάο",
+		"ja": "マカオ",
+		"zh": "澳门",
+		"ko": "마카오",
+		"ar": "مكّاو",
+	},
+	"MP": {
+		"en": "Northern Mariana Islands",
+		"de": "Nördliche Marianen",
+		"fr": "Îles Mariannes du Nord",
+		"es": "Islas Marianas del Norte",
+		"it": "Isole Marianne Settentrionali",
+		"nl": "Noordelijke Marianen",
+		"pt": "Ilhas Marianas do Norte",
+		"pl": "Mariany Północne",
+		"cs": "Severní Mariany",
+		"sv": "Nordmarianerna",
+		"da": "Nordmarianerne",
+		"nb": "Nord-Marianene",
+		"fi": "Pohjois-Mariaanit",
+		"ru": "Острова северной Марианы",
+		"tr": "Kuzey Mariana Adaları",
+		"el": "Βόρειες Μαριάνες Νήσοι",
+		"ja": "北マリアナ諸島",
+		"zh": "北马里亚纳群岛",
+		"ko": "북마리아나 제도",
+		"ar": "جزر ماريانا الشّماليّة",
+	},
+	"MQ": {
+		"en": "Martinique",
+		"es": "Martinica",
+		"it": "Martinica",
+		"pt": "Martinica",
+		"pl": "Martynika",
+		"cs": "Martinik",
+		"ru": "Мартиника",
+		"el": "Μαρτινίκα",
+		"ja": "マルティニーク",
+		"zh": "马提尼克",
+		"ko": "마르티니크",
+		"ar": "مارتينيك",
+	},
+	"MR": {
+		"en": "Mauritania",
+		"de": "Mauretanien",
+		"fr": "Mauritanie",
+		"nl": "Mauritanië",
+		"pt": "Mauritânia",
+		"pl": "Mauretania",
+		"cs": "Mauritánie",
+		"sv": "Mauretanien",
+		"da": "Mauretanien",
+		"ru": "Мавритания",
+		"tr": "Moritanya",
+		"el": "Μαυριτανία",
+		"ja": "モーリタニア",
+		"zh": "毛里塔尼亚",
+		"ko": "모리타니",
+		"ar": "موريتانيا",
+	},
+	"MS": {
+		"en": "Montserrat",
+		"pt": "Monserrate",
+		"ru": "Монтсеррат",
+		"el": "Μοντσεράτ",
+		"ja": "モントセラト",
+		"zh": "蒙塞拉特岛",
+		"ko": "몬트세랫",
+		"ar": "مونتسيرات",
+	},
+	"MT": {
+		"en": "Malta",
+		"fr": "Malte",
+		"ru": "Мальта",
+		"el": "Μάλτα",
+		"ja": "マルタ",
+		"zh": "马尔他",
+		"ko": "몰타",
+		"ar": "مالطة",
+	},
+	"MU": {
+		"en": "Mauritius",
+		"fr": "Maurice",
+		"es": "Mauricio",
+		"it": "Maurizio",
+		"pt": "Maurícia",
+		"cs": "Mauricius",
+		"ru": "Маврикий",
+		"el": "Μαυρίκιος",
+		"ja": "モーリシャス",
+		"zh": "毛里求斯",
+		"ko": "모리셔스",
+		"ar": "موريشيوس",
+	},
+	"MV": {
+		"en": "Maldives",
+		"de": "Malediven",
+		"es": "Islas Maldivas",
+		"it": "Maldive",
+		"nl": "Maldiven",
+		"pt": "Maldivas",
+		"pl": "Malediwy",
+		"cs": "Maledivy",
+		"sv": "Maldiverna",
+		"da": "Maldiverne",
+		"nb": "Maldivene",
+		"fi": "Malediivit",
+		"ru": "Мальдивы",
+		"tr": "Maldivler",
+		"el": "Μαλδίβες",
+		"ja": "モルディブ",
+		"zh": "马尔代夫",
+		"ko": "몰디브",
+		"ar": "جزر المالديف",
+	},
+	"MW": {
+		"en": "Malawi",
+		"es": "Malaui",
+		"ru": "Малави",
+		"tr": "Malavi",
+		"el": "Μαλάουι",
+		"ja": "マラウイ",
+		"zh": "马拉维",
+		"ko": "말라위",
+		"ar": "ملاوي",
+	},
+	"MX": {
+		"en": "Mexico",
+		"de": "Mexiko",
+		"fr": "Mexique",
+		"es": "México",
+		"it": "Messico",
+		"pt": "México",
+		"pl": "Meksyk",
+		"cs": "Mexiko",
+		"sv": "Mexiko",
+		"fi": "Meksiko",
+		"ru": "Мексика",
+		"tr": "Meksika",
+		"el": "Μεξικό",
+		"ja": "メキシコ",
+		"zh": "墨西哥",
+		"ko": "멕시코",
+		"ar": "المكسيك",
+	},
+	"MY": {
+		"en": "Malaysia",
+		"fr": "Malaisie",
+		"es": "Malasia",
+		"nl": "Maleisië",
+		"pt": "Malásia",
+		"pl": "Malezja",
+		"cs": "Malajsie",
+		"fi": "Malesia",
+		"ru": "Малайзия",
+		"tr": "Malezya",
+		"el": "Μαλαισία",
+		"ja": "マレーシア",
+		"zh": "马来西亚",
+		"ko": "말레이시아",
+		"ar": "ماليزيا",
+	},
+	"MZ": {
+		"en": "Mozambique",
+		"de": "Mosambik",
+		"it": "Mozambico",
+		"pt": "Moçambique",
+		"pl": "Mozambik",
+		"cs": "Mosambik",
+		"sv": "Moçambique",
+		"da": "Mocambique",
+		"nb": "Mosambik",
+		"fi": "Mosambik",
+		"ru": "Мозамбик",
+		"tr": "Mozambik",
+		"el": "Μοζαμβίκη",
+		"ja": "モザンビーク",
+		"zh": "莫桑比克",
+		"ko": "모잠비크",
+		"ar": "موزمبيق",
+	},
+	"NA": {
+		"en": "Namibia",
+		"fr": "Namibie",
+		"nl": "Namibië",
+		"pt": "Namíbia",
+		"cs": "Namibie",
+		"ru": "Намибия",
+		"tr": "Namibya",
+		"el": "Ναμίμπια",
+		"ja": "ナミビア",
+		"zh": "纳米比亚",
+		"ko": "나미비아",
+		"ar": "ناميبيا",
+	},
+	"NC": {
+		"en": "New Caledonia",
+		"de": "Neukaledonien",
+		"fr": "Nouvelle-Calédonie",
+		"es": "Nueva Caledonia",
+		"it": "Nuova Caledonia",
+		"nl": "Nieuw-Caledonië",
+		"pt": "Nova Caledónia",
+		"pl": "Nowa Kaledonia",
+		"cs": "Nová Kaledonie",
+		"sv": "Nya Kaledonien",
+		"da": "Ny Kaledonien",
+		"nb": "Ny-Caledonia",
+		"fi": "Uusi-Kaledonia",
+		"ru": "Новая Каледония",
+		"tr": "Yeni Kaledonya",
+		"el": "Νέα Καληδονία",
+		"ja": "ニューカレドニア",
+		"zh": "新喀里多尼亚",
+		"ko": "누벨칼레도니",
+		"ar": "نيو قلدونيا",
+	},
+	"NE": {
+		"en": "Niger",
+		"pt": "Níger",
+		"ru": "Нигер",
+		"tr": "Nijer",
+		"el": "Νίγηρας",
+		"ja": "ニジェール",
+		"zh": "尼日尔",
+		"ko": "니제르",
+		"ar": "النّيجر",
+	},
+	"NF": {
+		"en": "Norfolk Island",
+		"de": "Norfolkinsel",
+		"fr": "île Norfolk",
+		"es": "Isla Norfolk",
+		"it": "Isola Norfolk",
+		"nl": "Norfolk",
+		"pt": "Ilha Norfolk",
+		"pl": "Wyspy Norfolk",
+		"cs": "Norfolkský ostrov",
+		"sv": "Norfolköarna",
+		"da": "Norfolk Øen",
+		"nb": "Norfolkøya",
+		"fi": "Norfolkinsaari",
+		"ru": "Остров Норфолк",
+		"tr": "Norfolk Adası",
+		"el": "Νήσος Νόρφολκ",
+		"ja": "ノーフォーク島",
+		"zh": "诺福克岛",
+		"ko": "노퍽 섬",
+		"ar": "جزيرة نورفولك",
+	},
+	"NG": {
+		"en": "Nigeria",
+		"pt": "Nigéria",
+		"cs": "Nigérie",
+		"ru": "Нигерия",
+		"tr": "Nijerya",
+		"el": "Νιγηρία",
+		"ja": "ナイジェリア",
+		"zh": "尼日利亚",
+		"ko": "나이지리아",
+		"ar": "نيجيريا",
+	},
+	"NI": {
+		"en": "Nicaragua",
+		"pt": "Nicarágua",
+		"pl": "Nikaragua",
+		"cs": "Nikaragua",
+		"ru": "Никарагуа",
+		"tr": "Nikaragua",
+		"el": "Νικαράγουα",
+		"ja": "ニカラグア",
+		"zh": "尼加拉瓜",
+		"ko": "니카라과",
+		"ar": "نيكاراجوا",
+	},
+	"NL": {
+		"en": "Netherlands",
+		"de": "Niederlande",
+		"fr": "Pays-Bas",
+		"es": "Países Bajos",
+		"it": "Paesi Bassi",
+		"nl": "Nederland",
+		"pt": "Países Baixos",
+		"pl": "Holandia",
+		"cs": "Nizozemsko",
+		"sv": "Nederländerna",
+		"da": "Holland",
+		"nb": "Nederland",
+		"fi": "Alankomaat",
+		"ru": "Нидерланды",
+		"tr": "Hollanda",
+		"el": "Ολλανδία",
+		"ja": "オランダ",
+		"zh": "荷兰",
+		"ko": "네덜란드",
+		"ar": "هولندا",
+	},
+	"NO": {
+		"en": "Norway",
+		"de": "Norwegen",
+		"fr": "Norvège",
+		"es": "Noruega",
+		"it": "Norvegia",
+		"nl": "Noorwegen",
+		"pt": "Noruega",
+		"pl": "Norwegia",
+		"cs": "Norsko",
+		"sv": "Norge",
+		"da": "Norge",
+		"nb": "Norge",
+		"fi": "Norja",
+		"ru": "Норвегия",
+		"tr": "Norveç",
+		"el": "Νορβηγία",
+		"ja": "ノルウェー",
+		"zh": "挪威",
+		"ko": "노르웨이",
+		"ar": "النّرويج",
+	},
+	"NP": {
+		"en": "Nepal",
+		"fr": "Népal",
+		"cs": "Nepál",
+		"ru": "Непал",
+		"el": "Νεπάλ",
+		"ja": "ネパール",
+		"zh": "尼泊尔",
+		"ko": "네팔",
+		"ar": "نيبال",
+	},
+	"NR": {
+		"en": "Nauru",
+		"ru": "Науру",
+		"el": "Ναουρού",
+		"ja": "ナウル",
+		"zh": "瑙鲁",
+		"ko": "나우루",
+		"ar": "ناورو",
+	},
+	"NU": {
+		"en": "Niue",
+		"fr": "Nioue",
+		"ru": "Ниуэ",
+		"el": "Νιούεϊ",
+		"ja": "ニウエ",
+		"zh": "纽埃",
+		"ko": "니우에",
+		"ar": "نيوي",
+	},
+	"NZ": {
+		"en": "New Zealand",
+		"de": "Neuseeland",
+		"fr": "Nouvelle-Zélande",
+		"es": "Nueva Zelanda",
+		"it": "Nuova Zelanda",
+		"nl": "Nieuw-Zeeland",
+		"pt": "Nova Zelândia",
+		"pl": "Nowa Zelandia",
+		"cs": "Nový Zéland",
+		"sv": "Nya Zeeland",
+		"fi": "Uusi-Seelanti",
+		"ru": "Новая Зеландия",
+		"tr": "Yeni Zelanda",
+		"el": "Νέα Ζηλανδία",
+		"ja": "ニュージーランド",
+		"zh": "新西兰",
+		"ko": "뉴질랜드",
+		"ar": "نيوزيلاندا",
+	},
+	"OM": {
+		"en": "Oman",
+		"es": "Omán",
+		"pt": "Omã",
+		"cs": "Omán",
+		"ru": "Оман",
+		"tr": "Umman",
+		"el": "Ομάν",
+		"ja": "オマーン",
+		"zh": "阿曼",
+		"ko": "오만",
+		"ar": "عمان",
+	},
+	"PA": {
+		"en": "Panama",
+		"es": "Panamá",
+		"pt": "Panamá",
+		"ru": "Панама",
+		"el": "Παναμάς",
+		"ja": "パナマ",
+		"zh": "巴拿马",
+		"ko": "파나마",
+		"ar": "بنما",
+	},
+	"PE": {
+		"en": "Peru",
+		"fr": "Pérou",
+		"es": "Perú",
+		"it": "Perù",
+		"ru": "Перу",
+		"el": "Περού",
+		"ja": "ペルー",
+		"zh": "秘鲁",
+		"ko": "페루",
+		"ar": "البيرو",
+	},
+	"PF": {
+		"en": "French Polynesia",
+		"de": "Französisch-Polynesien",
+		"fr": "Polynésie française",
+		"es": "Polinesia Francesa",
+		"it": "Polinesia francese",
+		"nl": "Frans-Polynesië",
+		"pt": "Polinésia Francesa",
+		"pl": "Polinezja Francuska",
+		"cs": "Francouzská Polynésie",
+		"sv": "Franska Polynesien",
+		"da": "Fransk Polynesien",
+		"nb": "Fransk Polynesia",
+		"fi": "Ranskan Polynesia",
+		"ru": "Французская Полинезия",
+		"tr": "Fransız Polinezyası",
+		"el": "Γαλλική Πολυνησία",
+		"ja": "仏領ポリネシア",
+		"zh": "法属玻利尼西亚",
+		"ko": "프랑스령 폴리네시아",
+		"ar": "بولينيسيا الفرنسيّة",
+	},
+	"PG": {
+		"en": "Papua New Guinea",
+		"de": "Papua-Neuguinea",
+		"fr": "Papouasie-Nouvelle-Guinée",
+		"es": "Papúa Nueva Guinea",
+		"it": "Papua Nuova Guinea",
+		"nl": "Papoea-Nieuw-Guinea",
+		"pt": "Papua Nova Guiné",
+		"pl": "Papua-Nowa Gwinea",
+		"cs": "Papua Nová Guinea",
+		"sv": "Papua Nya Guinea",
+		"da": "Papua Ny Guinea",
+		"nb": "Papua Ny-Guinea",
+		"fi": "Papua-Uusi-Guinea",
+		"ru": "Папуа — Новая Гвинея",
+		"tr": "Papua Yeni Gine",
+		"el": "Παπούα Νέα Γουινέα",
+		"ja": "パプアニューギニア",
+		"zh": "巴布亚新几内亚",
+		"ko": "파푸아뉴기니",
+		"ar": "بابوا غينيا الجديدة",
+	},
+	"PH": {
+		"en": "Philippines",
+		"de": "Philippinen",
+		"es": "Filipinas",
+		"it": "Filippine",
+		"nl": "Filipijnen",
+		"pt": "Filipinas",
+		"pl": "Filipiny",
+		"cs": "Filipíny",
+		"sv": "Filippinerna",
+		"da": "Filippinerne",
+		"nb": "Filippinene",
+		"fi": "Filippiinit",
+		"ru": "Филиппины",
+		"tr": "Filipinler",
+		"el": "Φιλιππίνες",
+		"ja": "フィリピン",
+		"zh": "菲律宾",
+		"ko": "필리핀",
+		"ar": "الفلبّين",
+	},
+	"PK": {
+		"en": "Pakistan",
+		"es": "Pakistán",
+		"pt": "Paquistão",
+		"cs": "Pákistán",
+		"ru": "Пакистан",
+		"el": "Πακιστάν",
+		"ja": "パキスタン",
+		"zh": "巴基斯坦",
+		"ko": "파키스탄",
+		"ar": "باكستان",
+	},
+	"PL": {
+		"en": "Poland",
+		"de": "Polen",
+		"fr": "Pologne",
+		"es": "Polonia",
+		"it": "Polonia",
+		"nl": "Polen",
+		"pt": "Polónia",
+		"pl": "Polska",
+		"cs": "Polsko",
+		"sv": "Polen",
+		"da": "Polen",
+		"nb": "Polen",
+		"fi": "Puola",
+		"ru": "Польша",
+		"tr": "Polonya",
+		"el": "Πολωνία",
+		"ja": "ポーランド",
+		"zh": "波兰",
+		"ko": "폴란드",
+		"ar": "بولندا",
+	},
+	"PM": {
+		"en": "Saint Pierre and Miquelon",
+		"de": "St. Pierre und Miquelon",
+		"fr": "Saint-Pierre-et-Miquelon",
+		"es": "San Pedro y Miquelon",
+		"it": "Saint-Pierre e Miquelon",
+		"nl": "Saint-Pierre en Miquelon",
+		"pt": "Saint Pierre e Miquelon",
+		"pl": "Saint-Pierre i Miquelon",
+		"cs": "Svatý Pierre a Miquelon",
+		"sv": "Sankt Pierre och Miquelon",
+		"da": "Sankt Pierre og Miquelon",
+		"nb": "Saint-Pierre og Miquelon",
+		"fi": "Saint-Pierre ja Miquelon",
+		"ru": "Сен-Пьер и Микелон",
+		"tr": "Saint Pierre ve Miquelon",
+		"el": "Σαιν Πιερ και Μικελόν",
+		"ja": "サンピエール及びミクロン",
+		"zh": "圣皮埃尔和密克隆",
+		"ko": "생피에르 미클롱",
+		"ar": "سانت بيير و ميكيلون",
+	},
+	"PN": {
+		"en": "Pitcairn",
+		"fr": "Îles Pitcairn",
+		"nl": "Pitcairneilanden",
+		"cs": "Pitcairnovy ostrovy",
+		"ru": "Питкэрн",
+		"el": "Πίτκαϊρν",
+		"ja": "ピトケアン",
+		"zh": "皮特克恩",
+		"ko": "핏케언 제도",
+		"ar": "بتكيرن",
+	},
+	"PR": {
+		"en": "Puerto Rico",
+		"fr": "Porto Rico",
+		"it": "Portorico",
+		"pt": "Porto Rico",
+		"pl": "Portoryko",
+		"cs": "Portoriko",
+		"ru": "Пуэрто-Рико",
+		"tr": "Porto Riko",
+		"el": "Πουέρτο Ρίκο",
+		"ja": "プエルトリコ",
+		"zh": "波多黎各",
+		"ko": "푸에르토리코",
+		"ar": "بورتوريكو",
+	},
+	"PS": {
+		"en": "Palestine",
+		"pl": "Palestyna (państwo)",
+		"cs": "Palestinský stát",
+		"sv": "Staten Palestina",
+		"ru": "Палестина",
+		"tr": "Filistin Devleti",
+		"el": "Παλαιστίνη",
+		"ja": "パレスチナ",
+		"zh": "巴勒斯坦",
+		"ko": "팔레스타인",
+		"ar": "دولة فلسطين",
+	},
+	"PT": {
+		"en": "Portugal",
+		"it": "Portogallo",
+		"pl": "Portugalia",
+		"cs": "Portugalsko",
+		"fi": "Portugali",
+		"ru": "Португалия",
+		"tr": "Portekiz",
+		"el": "Πορτογαλία",
+		"ja": "ポルトガル",
+		"zh": "葡萄牙",
+		"ko": "포르투갈",
+		"ar": "البرتغال",
+	},
+	"PW": {
+		"en": "Palau",
+		"fr": "Palaos",
+		"es": "Palaos",
+		"ru": "Палау",
+		"el": "Παλάου",
+		"ja": "パラオ",
+		"zh": "帕劳",
+		"ko": "팔라우",
+		"ar": "بالاو",
+	},
+	"PY": {
+		"en": "Paraguay",
+		"pt": "Paraguai",
+		"pl": "Paragwaj",
+		"ru": "Парагвай",
+		"el": "Παραγουάη",
+		"ja": "パラグアイ",
+		"zh": "巴拉圭",
+		"ko": "파라과이",
+		"ar": "الباراغواي",
+	},
+	"QA": {
+		"en": "Qatar",
+		"de": "Katar",
+		"es": "Catar",
+		"pt": "Catar",
+		"pl": "Katar",
+		"cs": "Katar",
+		"ru": "Катар",
+		"tr": "Katar",
+		"el": "Κατάρ",
+		"ja": "カタール",
+		"zh": "卡塔尔",
+		"ko": "카타르",
+		"ar": "قطر",
+	},
+	"RE": {
+		"en": "Réunion",
+		"es": "Reunión",
+		"it": "Riunione",
+		"pt": "Ilha Reunião",
+		"pl": "Reunion",
+		"ru": "Реюньон",
+		"el": "Ρεϋνιόν",
+		"ja": "レユニオン",
+		"zh": "留尼汪",
+		"ko": "레위니옹",
+		"ar": "ريونيون",
+	},
+	"RO": {
+		"en": "Romania",
+		"de": "Rumänien",
+		"fr": "Roumanie",
+		"es": "Rumanía",
+		"nl": "Roemenië",
+		"pt": "Roménia",
+		"pl": "Rumunia",
+		"cs": "Rumunsko",
+		"sv": "Rumänien",
+		"da": "Rumænien",
+		"ru": "Румыния",
+		"tr": "Romanya",
+		"el": "Ρουμανία",
+		"ja": "ルーマニア",
+		"zh": "罗马尼亚",
+		"ko": "루마니아",
+		"ar": "رومانيا",
+	},
+	"RS": {
+		"en": "Serbia",
+		"de": "Serbien",
+		"fr": "Serbie",
+		"nl": "Servië",
+		"pt": "Sérvia",
+		"cs": "Srbsko",
+		"sv": "Serbien",
+		"da": "Serbien",
+		"ru": "Сербия",
+		"tr": "Sırbistan",
+		"el": "Σερβία",
+		"ja": "セルビア",
+		"zh": "塞尔维亚",
+		"ko": "세르비아",
+		"ar": "صربية",
+	},
+	"RU": {
+		"en": "Russia",
+		"de": "Russland",
+		"fr": "Russie",
+		"es": "Rusia",
+		"it": "Russia",
+		"nl": "Rusland",
+		"pt": "Rússia",
+		"pl": "Rosja",
+		"cs": "Rusko",
+		"sv": "Ryssland",
+		"da": "Rusland",
+		"nb": "Russland",
+		"fi": "Venäjä",
+		"ru": "Россия",
+		"tr": "Rusya",
+		"el": "Ρωσία",
+		"ja": "ロシア",
+		"zh": "俄罗斯",
+		"ko": "러시아",
+		"ar": "روسيا",
+	},
+	"RW": {
+		"en": "Rwanda",
+		"de": "Ruanda",
+		"es": "Ruanda",
+		"it": "Ruanda",
+		"pt": "Ruanda",
+		"pl": "Ruanda",
+		"fi": "Ruanda",
+		"ru": "Руанда",
+		"tr": "Ruanda",
+		"el": "Ρουάντα",
+		"ja": "ルワンダ",
+		"zh": "卢旺达",
+		"ko": "르완다",
+		"ar": "رواندا",
+	},
+	"SA": {
+		"en": "Saudi Arabia",
+		"de": "Saudi-Arabien",
+		"fr": "Arabie saoudite",
+		"es": "Arabia Saudí",
+		"it": "Arabia Saudita",
+		"nl": "Saoedi-Arabië",
+		"pt": "Arábia Saudita",
+		"pl": "Arabia Saudyjska",
+		"cs": "Saúdská Arábie",
+		"sv": "Saudiarabien",
+		"da": "Saudi-Arabien",
+		"nb": "Saudi-Arabia",
+		"fi": "Saudi-Arabia",
+		"ru": "Саудовская Аравия",
+		"tr": "Suudi Arabistan",
+		"el": "Σαουδική Αραβία",
+		"ja": "サウジアラビア",
+		"zh": "沙特阿拉伯",
+		"ko": "사우디아라비아",
+		"ar": "السّعوديّة",
+	},
+	"SB": {
+		"en": "Solomon Islands",
+		"de": "Salomoninseln",
+		"es": "Islas Salomón",
+		"it": "Isole Salomone",
+		"nl": "Salomonseilanden",
+		"pt": "Ilhas Salomão",
+		"pl": "Wyspy Salomona",
+		"cs": "Šalamounovy ostrovy",
+		"sv": "Salomonöarna",
+		"da": "Salomonøerne",
+		"nb": "Salomonøyene",
+		"fi": "Salomonsaaret",
+		"ru": "Соломоновы Острова",
+		"tr": "Solomon Adaları",
+		"el": "Νήσοι Σολομώντα",
+		"ja": "ソロモン諸島",
+		"zh": "所罗门群岛",
+		"ko": "솔로몬 제도",
+		"ar": "جزر سولومن",
+	},
+	"SC": {
+		"en": "Seychelles",
+		"de": "Seychellen",
+		"nl": "Seychellen",
+		"pl": "Seszele",
+		"cs": "Seychely",
+		"sv": "Seychellerna",
+		"da": "Seychellerne",
+		"nb": "Seychellene",
+		"fi": "Seychellit",
+		"ru": "Сейшелы",
+		"tr": "Seyşeller",
+		"el": "Σεϋχέλλες",
+		"ja": "セーシェル",
+		"zh": "塞舌尔",
+		"ko": "세이셸",
+		"ar": "السّيشل",
+	},
+	"SD": {
+		"en": "Sudan",
+		"fr": "Soudan",
+		"es": "Sudán",
+		"nl": "Soedan",
+		"pt": "Sudão",
+		"cs": "Súdán",
+		"ru": "Судан",
+		"el": "Σουδάν",
+		"ja": "スーダン",
+		"zh": "苏丹",
+		"ko": "수단",
+		"ar": "السّودان",
+	},
+	"SE": {
+		"en": "Sweden",
+		"de": "Schweden",
+		"fr": "Suède",
+		"es": "Suecia",
+		"it": "Svezia",
+		"nl": "Zweden",
+		"pt": "Suécia",
+		"pl": "Szwecja",
+		"cs": "Švédsko",
+		"sv": "Sverige",
+		"da": "Sverige",
+		"nb": "Sverige",
+		"fi": "Ruotsi",
+		"ru": "Швеция",
+		"tr": "İsveç",
+		"el": "Σουηδία",
+		"ja": "スウェーデン",
+		"zh": "瑞典",
+		"ko": "스웨덴",
+		"ar": "السّويد",
+	},
+	"SG": {
+		"en": "Singapore",
+		"de": "Singapur",
+		"fr": "Singapour",
+		"es": "Singapur",
+		"pt": "Singapura",
+		"pl": "Singapur",
+		"cs": "Singapur",
+		"ru": "Сингапур",
+		"tr": "Singapur",
+		"el": "Σιγκαπούρη",
+		"ja": "シンガポール",
+		"zh": "新加坡",
+		"ko": "싱가포르",
+		"ar": "سنغافورة",
+	},
+	"SH": {
+		"en": "Saint Helena",
+		"ja": "セントヘレナ、アセンション及びトリスタン・ダ・クーニャ",
+		"zh": "圣赫勒拿-阿森松-特里斯坦达库尼亚",
+		"ko": "세인트헬레나 어센션 트리스탄다쿠냐",
+		"ar": "ساينت هيلينا، تريستان دا كونا",
+	},
+	"SI": {
+		"en": "Slovenia",
+		"de": "Slowenien",
+		"fr": "Slovénie",
+		"es": "Eslovenia",
+		"nl": "Slovenië",
+		"pt": "Eslovénia",
+		"pl": "Słowenia",
+		"cs": "Slovinsko",
+		"sv": "Slovenien",
+		"da": "Slovenien",
+		"ru": "Словения",
+		"tr": "Slovenya",
+		"el": "Σλοβενία",
+		"ja": "スロベニア",
+		"zh": "斯洛文尼亚",
+		"ko": "슬로베니아",
+		"ar": "سلوفينيا",
+	},
+	"SJ": {
+		"en": "Svalbard and Jan Mayen",
+		"de": "Svalbard und Jan Mayen",
+		"fr": "Svalbard et île Jan Mayen",
+		"es": "Svalbard y Jan Mayen",
+		"it": "Svalbard e Jan Mayen",
+		"nl": "Spitsbergen en Jan Mayen",
+		"pt": "Svalbard e Jan Mayen",
+		"pl": "Svalbard i Jan Mayen",
+		"cs": "Svalbard a Jan Mayen",
+		"sv": "Svalbard och Jan Mayen",
+		"da": "Svalbard og Jan Mayen",
+		"nb": "Svalbard og Jan Mayen",
+		"fi": "Svalbard ja Jan Mayen",
+		"ru": "Шпицберген и Ян-Майен",
+		"tr": "Svalbard ve Jan Mayen",
+		"el": "Σβάλμπαρντ και Γιαν Μαγέν",
+		"ja": "スヴァールバル及びヤンマイエン",
+		"zh": "斯瓦尔巴特和扬马延岛",
+		"ko": "스발바르 얀마옌 제도",
+		"ar": "سفالبارد و جان ماين",
+	},
+	"SK": {
+		"en": "Slovakia",
+		"de": "Slowakei",
+		"fr": "Slovaquie",
+		"es": "Eslovaquia",
+		"it": "Slovacchia",
+		"nl": "Slowakije",
+		"pt": "Eslováquia",
+		"pl": "Słowacja",
+		"cs": "Slovensko",
+		"sv": "Slovakien",
+		"da": "Slovakiet",
+		"ru": "Словакия",
+		"tr": "Slovakya",
+		"el": "Σλοβακία",
+		"ja": "スロバキア",
+		"zh": "斯洛伐克",
+		"ko": "슬로바키아",
+		"ar": "سلوفاكيا",
+	},
+	"SL": {
+		"en": "Sierra Leone",
+		"es": "Sierra Leona",
+		"pt": "Serra Leoa",
+		"ru": "Сьерра-Леоне",
+		"el": "Σιέρα Λεόνε",
+		"ja": "シエラレオネ",
+		"zh": "塞拉利昂",
+		"ko": "시에라리온",
+		"ar": "سيراليون",
+	},
+	"SM": {
+		"en": "San Marino",
+		"fr": "Saint-Marin",
+		"ru": "Сан-Марино",
+		"el": "Άγιος Μαρίνος",
+		"ja": "サンマリノ",
+		"zh": "圣马力诺市",
+		"ko": "산마리노",
+		"ar": "سان مارينو",
+	},
+	"SN": {
+		"en": "Senegal",
+		"fr": "Sénégal",
+		"ru": "Сенегал",
+		"el": "Σενεγάλη",
+		"ja": "セネガル",
+		"zh": "塞内加尔",
+		"ko": "세네갈",
+		"ar": "السّنغال",
+	},
+	"SO": {
+		"en": "Somalia",
+		"fr": "Somalie",
+		"nl": "Somalië",
+		"pt": "Somália",
+		"cs": "Somálsko",
+		"ru": "Сомали",
+		"tr": "Somali",
+		"el": "Σομαλία",
+		"ja": "ソマリア",
+		"zh": "索马里",
+		"ko": "소말리아",
+		"ar": "الصّومال",
+	},
+	"SR": {
+		"en": "Suriname",
+		"fr": "Surinam",
+		"es": "Surinám",
+		"pl": "Surinam",
+		"cs": "Surinam",
+		"sv": "Surinam",
+		"da": "Surinam",
+		"nb": "Surinam",
+		"ru": "Суринам",
+		"tr": "Surinam",
+		"el": "Σουρινάμ",
+		"ja": "スリナム",
+		"zh": "苏里南",
+		"ko": "수리남",
+		"ar": "سورينام",
+	},
+	"SS": {
+		"en": "South Sudan",
+		"de": "Südsudan",
+		"fr": "Soudan du Sud",
+		"es": "Sudán del Sur",
+		"it": "Sudan del sud",
+		"nl": "Zuid-Soedan",
+		"pt": "Sudão do Sul",
+		"pl": "Sudan Południowy",
+		"cs": "Jižní Súdán",
+		"sv": "Sydsudan",
+		"da": "Sydsudan",
+		"nb": "Sør-Sudan",
+		"fi": "Etelä-Sudan",
+		"ru": "Южный Судан",
+		"tr": "Güney Sudan",
+		"el": "Νότιο Σουδάν",
+		"ja": "南スーダン",
+		"zh": "南苏丹",
+		"ko": "남수단",
+		"ar": "جنوب السّودان",
+	},
+	"ST": {
+		"en": "Sao Tome and Principe",
+		"de": "São Tomé und Príncipe",
+		"fr": "Sao Tomé-et-Principe",
+		"es": "Santo Tomé y Príncipe",
+		"it": "São Tomé e Príncipe",
+		"nl": "Sao Tomé en Principe",
+		"pt": "São Tomé e Príncipe",
+		"pl": "Wyspy Świętego Tomasza i Książęca",
+		"cs": "Svatý Tomáš a Princův ostrov",
+		"sv": "São Tomé och Príncipe",
+		"da": "São Tomé og Príncipe",
+		"nb": "São Tomé og Príncipe",
+		"fi": "São Tomé ja Príncipe",
+		"ru": "Сан-Томе и Принсипи",
+		"tr": "Sao Tome ve Principe",
+		"el": "Σάο Τομέ και Πρίνσιπε",
+		"ja": "サントメ・プリンシペ",
+		"zh": "圣多美和普林西比",
+		"ko": "상투메 프린시페",
+		"ar": "ساو تومي و برنسبي",
+	},
+	"SV": {
+		"en": "El Salvador",
+		"fr": "Salvador",
+		"pl": "Salwador",
+		"cs": "Salvador",
+		"ru": "Сальвадор",
+		"el": "Ελ Σαλβαδόρ",
+		"ja": "エルサルバドル",
+		"zh": "萨尔瓦多",
+		"ko": "엘살바도르",
+		"ar": "السّلفادور",
+	},
+	"SX": {
+		"en": "Sint Maarten",
+		"de": "Saint-Martin (Niederländischer Teil)",
+		"fr": "Saint-Martin (partie néerlandaise)",
+		"es": "Isla de San Martín (zona holandsea)",
+		"it": "Sint Maarten (Olanda)",
+		"nl": "Sint Maarten (Nederlands deel)",
+		"pt": "São Martinho (Países Baixos)",
+		"pl": "Sint Maarten (część holenderska)",
+		"cs": "Svatý Martin (nizozemská část)",
+		"sv": "Sint Maarten (nederländska delen)",
+		"da": "Sint Maarten (hollandsk del)",
+		"nb": "Sint Maarten (nederlandsk del)",
+		"fi": "Sint Maarten (hollantilainen osa)",
+		"ru": "Синт-Мартен (голландская часть)",
+		"tr": "Sint Maarten (Hollanda kısmı)",
+		"el": "Άγιος Μαρτίνος (Ολλανδικό τμήμα)",
+		"ja": "サンマルタン (オランダ領)",
+		"zh": "荷属圣马丁",
+		"ko": "신트마르턴 (네덜란드령)",
+		"ar": "سانت مارتن (الجزء الهولندي)",
+	},
+	"SY": {
+		"en": "Syria",
+		"de": "Syrien",
+		"nl": "Syrië",
+		"cs": "Sýrie",
+		"sv": "Syrien",
+		"tr": "Suriye",
+		"zh": "叙利亚",
+	},
+	"SZ": {
+		"en": "Eswatini",
+		"es": "Esuatini",
+		"pt": "Suazilândia",
+		"cs": "Svazijsko",
+		"sv": "Swaziland",
+		"nb": "Eswatini (tidligere Swasiland)",
+		"ru": "Эсватини",
+		"el": "Εσουατίνι",
+		"zh": "斯威士兰",
+		"ko": "에스와티니",
+		"ar": "إسواتيني",
+	},
+	"TC": {
+		"en": "Turks and Caicos Islands",
+		"de": "Turks- und Caicosinseln",
+		"fr": "îles Turques-et-Caïques",
+		"es": "Islas Turcas y Caicos",
+		"it": "Isole Turks e Caicos",
+		"nl": "Turks- en Caicoseilanden",
+		"pt": "Ilhas Turcas e Caicos",
+		"pl": "Turks i Caicos",
+		"cs": "Turks a Caicos",
+		"sv": "Turks- och Caicosöarna",
+		"da": "Turks- og Caicosøerne",
+		"nb": "Turks- og Caicosøyene",
+		"fi": "Turks- ja Caicossaaret",
+		"ru": "Острова Туркс и Каикос",
+		"tr": "Turks ve Caicos Adaları",
+		"el": "Τερκς και Κάικος Νήσοι",
+		"ja": "タークス及びカイコス諸島",
+		"zh": "特克斯和凯科斯群岛",
+		"ko": "터크스 케이커스 제도",
+		"ar": "جزر التّرك و الكايكوس",
+	},
+	"TD": {
+		"en": "Chad",
+		"de": "Tschad",
+		"fr": "Tchad",
+		"it": "Ciad",
+		"nl": "Tsjaad",
+		"pt": "Chade",
+		"pl": "Czad",
+		"cs": "Čad",
+		"sv": "Tchad",
+		"da": "Tchad",
+		"nb": "Tsjad",
+		"fi": "Tšad",
+		"ru": "Чад",
+		"tr": "Çad",
+		"el": "Τσαντ",
+		"ja": "チャド",
+		"zh": "乍得",
+		"ko": "차드",
+		"ar": "تشاد",
+	},
+	"TF": {
+		"en": "French Southern Territories",
+		"de": "Französische Süd- und Antarktisgebiete",
+		"fr": "Terres australes françaises",
+		"es": "Territorios Franceses del Sur",
+		"it": "Territori francesi meridionali",
+		"nl": "Franse Zuidelijke Gebieden",
+		"pt": "Territórios Franceses do Sul",
+		"pl": "Francuskie Terytoria Południowe",
+		"cs": "Francouzská jižní území",
+		"sv": "Franska sydterritorierna",
+		"da": "Sydlige Franske Territorier",
+		"nb": "Franske sørlige territorier",
+		"fi": "Ranskan eteläiset alueet",
+		"ru": "Французские южные территории",
+		"tr": "Fransız Güney Bölgeleri",
+		"el": "Γαλλικά Νότια Εδάφη",
+		"ja": "フランス南方領土",
+		"zh": "法属南半球领地",
+		"ko": "프랑스령 남 자치구역",
+		"ar": "المقاطعات الفرنسيّة الجنوبيّة",
+	},
+	"TG": {
+		"en": "Togo",
+		"ru": "Того",
+		"el": "Τόγκο",
+		"ja": "トーゴ",
+		"zh": "多哥",
+		"ko": "토고",
+		"ar": "توغو",
+	},
+	"TH": {
+		"en": "Thailand",
+		"fr": "Thaïlande",
+		"es": "Tailandia",
+		"it": "Thailandia",
+		"pt": "Tailândia",
+		"pl": "Tajlandia",
+		"cs": "Thajsko",
+		"fi": "Thaimaa",
+		"ru": "Таиланд",
+		"tr": "Tayland",
+		"el": "Ταϊλάνδη",
+		"ja": "タイ",
+		"zh": "泰国",
+		"ko": "태국",
+		"ar": "تايلاند",
+	},
+	"TJ": {
+		"en": "Tajikistan",
+		"de": "Tadschikistan",
+		"fr": "Tadjikistan",
+		"es": "Tayikistán",
+		"it": "Tagikistan",
+		"nl": "Tadzjikistan",
+		"pt": "Tajiquistão",
+		"pl": "Tadżykistan",
+		"cs": "Tádžikistán",
+		"sv": "Tadzjikistan",
+		"da": "Tadsjikistan",
+		"nb": "Tadsjikistan",
+		"fi": "Tadžikistan",
+		"ru": "Таджикистан",
+		"tr": "Tacikistan",
+		"el": "Τατζικιστάν",
+		"ja": "タジキスタン",
+		"zh": "塔吉克斯坦",
+		"ko": "타지키스탄",
+		"ar": "طاجيكستان",
+	},
+	"TK": {
+		"en": "Tokelau",
+		"ru": "Токелау",
+		"el": "Τοκελάου",
+		"ja": "トケラウ",
+		"zh": "托克劳",
+		"ko": "토켈라우",
+		"ar": "جزر توكيلو",
+	},
+	"TL": {
+		"en": "Timor-Leste",
+		"fr": "Timor oriental",
+		"es": "Timor Oriental",
+		"it": "Timor Est",
+		"nl": "Oost-Timor",
+		"pl": "Timor Wschodni",
+		"cs": "Východní Timor",
+		"sv": "Östtimor",
+		"nb": "Øst-Timor",
+		"fi": "Itä-Timor",
+		"ru": "Восточный Тимор",
+		"el": "Τιμόρ-Λέστε",
+		"ja": "東ティモール",
+		"zh": "东帝汶",
+		"ko": "동티모르",
+		"ar": "تيمور-ليستي",
+	},
+	"TM": {
+		"en": "Turkmenistan",
+		"fr": "Turkménistan",
+		"es": "Turkmenistán",
+		"pt": "Turquemenistão",
+		"cs": "Turkmenistán",
+		"ru": "Туркменистан",
+		"tr": "Türkmenistan",
+		"el": "Τουρκμενιστάν",
+		"ja": "トルクメニスタン",
+		"zh": "土库曼斯坦",
+		"ko": "투르크메니스탄",
+		"ar": "تركمانستان",
+	},
+	"TN": {
+		"en": "Tunisia",
+		"de": "Tunesien",
+		"fr": "Tunisie",
+		"es": "Tunez",
+		"nl": "Tunesië",
+		"pt": "Tunísia",
+		"pl": "Tunezja",
+		"cs": "Tunisko",
+		"sv": "Tunisien",
+		"da": "Tunesien",
+		"ru": "Тунис",
+		"tr": "Tunus",
+		"el": "Τυνησία",
+		"ja": "チュニジア",
+		"zh": "突尼斯",
+		"ko": "튀니지",
+		"ar": "تونس",
+	},
+	"TO": {
+		"en": "Tonga",
+		"ru": "Тонга",
+		"el": "Τόνγκα",
+		"ja": "トンガ",
+		"zh": "汤加",
+		"ko": "통가",
+		"ar": "تونغا",
+	},
+	"TR": {
+		"en": "Türkiye",
+		"de": "Türkei",
+		"nl": "Turkije",
+		"pt": "Turquia",
+		"pl": "Turcja",
+		"cs": "Turecko",
+		"sv": "Turkiet",
+		"zh": "土耳其",
+		"ko": "튀르키예",
+	},
+	"TT": {
+		"en": "Trinidad and Tobago",
+		"de": "Trinidad und Tobago",
+		"fr": "Trinité-et-Tobago",
+		"es": "Trinidad y Tobago",
+		"it": "Trinidad e Tobago",
+		"nl": "Trinidad en Tobago",
+		"pt": "Trindade e Tobago",
+		"pl": "Trynidad i Tobago",
+		"cs": "Trinidad a Tobago",
+		"sv": "Trinidad och Tobago",
+		"da": "Trinidad og Tobago",
+		"nb": "Trinidad og Tobago",
+		"fi": "Trinidad ja Tobago",
+		"ru": "Тринидад и Тобаго",
+		"tr": "Trinidad ve Tobago",
+		"el": "Τρινιντάντ και Τομπάγκο",
+		"ja": "トリニダード・トバゴ",
+		"zh": "特里尼达和多巴哥",
+		"ko": "트리니다드 토바고",
+		"ar": "ترينيداد و توباغو",
+	},
+	"TV": {
+		"en": "Tuvalu",
+		"ru": "Тувалу",
+		"el": "Τουβαλού",
+		"ja": "ツバル",
+		"zh": "图瓦卢",
+		"ko": "투발루",
+		"ar": "توفالو",
+	},
+	"TW": {
+		"en": "Taiwan",
+		"fr": "Taïwan",
+		"es": "Taiwán",
+		"pl": "Tajwan",
+		"cs": "Tchaj-wan",
+		"ru": "Тайвань",
+		"tr": "Tayvan",
+		"el": "Ταϊβάν",
+		"ja": "台湾",
+		"zh": "台湾",
+		"ko": "타이완",
+		"ar": "تايوان",
+	},
+	"TZ": {
+		"en": "Tanzania",
+		"de": "Tansania",
+		"fr": "Tanzanie",
+		"pt": "Tanzânia",
+		"cs": "Tanzánie",
+		"ru": "Танзания",
+		"tr": "Tanzanya",
+		"el": "Τανζανία",
+		"ja": "タンザニア",
+		"zh": "坦桑尼亚",
+		"ko": "탄자니아",
+		"ar": "تنزانيا",
+	},
+	"UA": {
+		"en": "Ukraine",
+		"es": "Ucrania",
+		"it": "Ucraina",
+		"nl": "Oekraïne",
+		"pt": "Ucrânia",
+		"pl": "Ukraina",
+		"cs": "Ukrajina",
+		"sv": "Ukraina",
+		"nb": "Ukraina",
+		"fi": "Ukraina",
+		"ru": "Украина",
+		"tr": "Ukrayna",
+		"el": "Ουκρανία",
+		"ja": "ウクライナ",
+		"zh": "乌克兰",
+		"ko": "우크라이나",
+		"ar": "أوكرانيا",
+	},
+	"UG": {
+		"en": "Uganda",
+		"fr": "Ouganda",
+		"nl": "Oeganda",
+		"ru": "Уганда",
+		"el": "Ουγκάντα",
+		"ja": "ウガンダ",
+		"zh": "乌干达",
+		"ko": "우간다",
+		"ar": "أوغندا",
+	},
+	"UM": {
+		"en": "United States Minor Outlying Islands",
+		"fr": "Îles mineures éloignées des États-Unis",
+		"es": "Islas Ultramarinas Menores de Estados Unidos",
+		"it": "Isole minori esterne degli Stati Uniti d'America",
+		"nl": "Kleine afgelegen eilanden van de Verenigde Staten",
+		"pt": "Ilhas Menores Distantes dos Estados Unidos",
+		"pl": "Dalekie Wyspy Mniejsze Stanów Zjednoczonych",
+		"cs": "Menší odlehlé ostrovy Spojených států",
+		"sv": "Förenta staternas mindre öar i Oceanien och Västindien",
+		"da": "USA's ydre småøer",
+		"nb": "Mindre utenforliggende øyer til USA",
+		"fi": "Yhdysvaltain pienet erillissaaret",
+		"ru": "Соединенные штаты Малых Удаленных островов",
+		"tr": "Amerika Birleşik Devletleri Küçük Dış Adaları",
+		"el": "Απομακρυσμένες Νησίδες των Ηνωμένων Πολιτειών",
+		"ja": "アメリカ合衆国外諸島",
+		"zh": "美国本土外小岛屿",
+		"ko": "미국령 군소 제도",
+		"ar": "جزر الولايات المتّحدة الصّغرى النّائية",
+	},
+	"US": {
+		"en": "United States",
+		"de": "Vereinigte Staaten",
+		"fr": "États-Unis",
+		"es": "Estados Unidos",
+		"it": "Stati Uniti",
+		"nl": "Verenigde Staten",
+		"pt": "Estados Unidos",
+		"pl": "Stany Zjednoczone",
+		"cs": "Spojené státy",
+		"sv": "USA",
+		"da": "USA",
+		"nb": "De forente stater",
+		"fi": "Yhdysvallat",
+		"ru": "Соединённые штаты",
+		"tr": "Amerika Birleşik Devletleri",
+		"el": "Ηνωμένες Πολιτείες",
+		"ja": "米国",
+		"zh": "美国",
+		"ko": "미국",
+		"ar": "الولايات المتّحدة",
+	},
+	"UY": {
+		"en": "Uruguay",
+		"pt": "Uruguai",
+		"pl": "Urugwaj",
+		"ru": "Уругвай",
+		"el": "Ουρουγουάη",
+		"ja": "ウルグアイ",
+		"zh": "乌拉圭",
+		"ko": "우루과이",
+		"ar": "الأوروغواي",
+	},
+	"UZ": {
+		"en": "Uzbekistan",
+		"de": "Usbekistan",
+		"fr": "Ouzbékistan",
+		"es": "Uzbekistán",
+		"nl": "Oezbekistan",
+		"pt": "Uzbequistão",
+		"cs": "Uzbekistán",
+		"da": "Usbekistan",
+		"nb": "Usbekistan",
+		"ru": "Узбекистан",
+		"tr": "Özbekistan",
+		"el": "Ουζμπεκιστάν",
+		"ja": "ウズベキスタン",
+		"zh": "乌兹别克斯坦",
+		"ko": "우즈베키스탄",
+		"ar": "أوزبكستان",
+	},
+	"VA": {
+		"en": "Vatican City",
+		"de": "Vatikanstadt",
+		"fr": "Vatican",
+		"es": "Ciudad del Vaticano",
+		"it": "Città del Vaticano",
+		"nl": "Vaticaanstad",
+		"pt": "Cidade do Vaticano",
+		"pl": "Watykan",
+		"cs": "Vatikán",
+		"sv": "Vatikanstaten",
+		"da": "Vatikanstaten",
+		"nb": "Vatikanstaten",
+		"fi": "Vatikaani",
+		"ru": "Ватикан",
+		"tr": "Vatikan",
+		"el": "Βατικανό",
+		"ja": "バチカン市国",
+		"zh": "梵蒂冈",
+		"ko": "바티칸 시국",
+		"ar": "الفاتيكان",
+	},
+	"VC": {
+		"en": "Saint Vincent and the Grenadines",
+		"de": "St. Vincent und die Grenadinen",
+		"fr": "Saint-Vincent-et-les-Grenadines",
+		"es": "San Vicente y las Granadinas",
+		"it": "Saint Vincent e Grenadine",
+		"nl": "Saint Vincent en de Grenadines",
+		"pt": "São Vicente e Granadinas",
+		"pl": "Saint Vincent i Grenadyny",
+		"cs": "Svatý Vincenc a Grenadiny",
+		"sv": "Sankt Vincent och Grenadinerna",
+		"da": "Sankt Vincent og Grenadinerne",
+		"nb": "Saint Vincent og Grenadinene",
+		"fi": "Saint Vincent ja Grenadiinit",
+		"ru": "Сент-Винсент и Гренадины",
+		"tr": "Saint Vincent ve Grenadinler",
+		"el": "Άγιος Βικέντιος και Γρεναδίνες",
+		"ja": "セントビンセント及びグレナディーン諸島",
+		"zh": "圣文森特和格林纳丁斯",
+		"ko": "세인트빈센트 그레나딘",
+		"ar": "سانت فنسنت و جزر الغرينادين",
+	},
+	"VE": {
+		"en": "Venezuela",
+		"fr": "Vénézuela",
+		"pl": "Wenezuela",
+		"ru": "Венесуэла",
+		"el": "Βενεζουέλα",
+		"ja": "ベネズエラ",
+		"zh": "委内瑞拉",
+		"ko": "베네수엘라",
+		"ar": "فنزويلّا",
+	},
+	"VG": {
+		"en": "British Virgin Islands",
+		"de": "Britische Jungferninseln",
+		"fr": "Îles Vierges britanniques",
+		"pl": "Brytyjskie Wyspy Dziewicze",
+		"nb": "Jomfruøyene (Storbritannia)",
+		"ru": "Виргинские острова (Британия)",
+		"tr": "İngiliz Virgin Adaları",
+		"ja": "英領ヴァージン諸島",
+		"zh": "英属维尔京群岛",
+		"ar": "فيرجن، جزر فيرجن البريطانيّة",
+	},
+	"VI": {
+		"en": "U.S. Virgin Islands",
+		"de": "Amerikanische Jungferninseln",
+		"pl": "Wyspy Dziewicze Stanów Zjednoczonych",
+		"nb": "Jomfruøyene (USA)",
+		"ru": "Виргинские острова (США)",
+		"ja": "米領ヴァージン諸島",
+		"zh": "美属维尔京群岛",
+		"ar": "فيرجن، جزر فيرجن الأميركيّة",
+	},
+	"VN": {
+		"en": "Vietnam",
+		"fr": "Viêt Nam",
+		"pt": "Vietname",
+		"pl": "Wietnam",
+		"ru": "Вьетнам",
+		"el": "Βιετνάμ",
+		"ja": "ベトナム",
+		"zh": "越南",
+		"ko": "베트남",
+		"ar": "الفيتنام",
+	},
+	"VU": {
+		"en": "Vanuatu",
+		"ru": "Вануату",
+		"el": "Βανουάτου",
+		"ja": "バヌアツ",
+		"zh": "瓦努阿图",
+		"ko": "바누아투",
+		"ar": "فانواتو",
+	},
+	"WF": {
+		"en": "Wallis and Futuna",
+		"de": "Wallis und Futuna",
+		"fr": "Wallis et Futuna",
+		"es": "Wallis y Futuna",
+		"it": "Wallis e Futuna",
+		"nl": "Wallis en Futuna",
+		"pt": "Wallis e Futuna",
+		"pl": "Wallis i Futuna",
+		"cs": "Wallis a Futuna",
+		"sv": "Wallis och Futuna",
+		"da": "Wallis og Futunaøerne",
+		"nb": "Wallis og Futunaøyene",
+		"fi": "Wallis ja Futuna",
+		"ru": "Уоллес и Футана",
+		"tr": "Wallis ve Futuna Adaları",
+		"el": "Ουαλίς και Φουτούνα",
+		"ja": "ワリー及びフテュナ",
+		"zh": "瓦利斯和富图纳",
+		"ko": "왈리스 퓌튀나",
+		"ar": "واليس و فوتونا",
+	},
+	"WS": {
+		"en": "Samoa",
+		"ru": "Самоа",
+		"el": "Σαμόα",
+		"ja": "サモア",
+		"zh": "萨摩亚",
+		"ko": "사모아",
+		"ar": "صاموا",
+	},
+	"YE": {
+		"en": "Yemen",
+		"de": "Jemen",
+		"fr": "Yémen",
+		"nl": "Jemen",
+		"pt": "Iémen",
+		"pl": "Jemen",
+		"cs": "Jemen",
+		"nb": "Jemen",
+		"fi": "Jemen",
+		"ru": "Йемен",
+		"el": "Υεμένη",
+		"ja": "イエメン",
+		"zh": "也门",
+		"ko": "예멘",
+		"ar": "اليمن",
+	},
+	"YT": {
+		"en": "Mayotte",
+		"pl": "Majotta",
+		"ru": "Майот",
+		"el": "Μαγιότ",
+		"ja": "マヨット",
+		"zh": "马约特",
+		"ko": "마요트",
+		"ar": "مايوت",
+	},
+	"ZA": {
+		"en": "South Africa",
+		"de": "Südafrika",
+		"fr": "Afrique du Sud",
+		"es": "Sudáfrica",
+		"it": "Sudafrica",
+		"nl": "Zuid-Afrika",
+		"pt": "África do Sul",
+		"pl": "Południowa Afryka",
+		"cs": "Jihoafrická republika",
+		"sv": "Sydafrika",
+		"da": "Sydafrika",
+		"nb": "Sør-Afrika",
+		"fi": "Etelä-Afrikka",
+		"ru": "Южная Африка",
+		"tr": "Güney Afrika",
+		"el": "Νότια Αφρική",
+		"ja": "南アフリカ",
+		"zh": "南非",
+		"ko": "남아프리카 공화국",
+		"ar": "جنوب إفريقيا",
+	},
+	"ZM": {
+		"en": "Zambia",
+		"de": "Sambia",
+		"fr": "Zambie",
+		"pt": "Zâmbia",
+		"cs": "Zambie",
+		"fi": "Sambia",
+		"ru": "Замбия",
+		"tr": "Zambiya",
+		"el": "Ζάμπια",
+		"ja": "ザンビア",
+		"zh": "赞比亚",
+		"ko": "잠비아",
+		"ar": "زامبيا",
+	},
+	"ZW": {
+		"en": "Zimbabwe",
+		"de": "Simbabwe",
+		"es": "Zimbabue",
+		"pt": "Zimbábue",
+		"ru": "Зимбабве",
+		"tr": "Zimbabve",
+		"el": "Ζιμπάμπουε",
+		"ja": "ジンバブエ",
+		"zh": "津巴布韦",
+		"ko": "짐바브웨",
+		"ar": "زمبابوي",
+	},
+}
+
+// countryNameVariants holds official and alternative names of countries that are only used to resolve country codes
+var countryNameVariants = map[string][]string{
+	"AD": {"Principality of Andorra", "Fürstentum Andorra", "Principauté d'Andorre", "Principado de Andorra", "Principato d'Andorra", "Vorstendom Andorra", "Księstwo Andory", "Andorrské knížectví", "Furstendömet Andorra", "Fyrstendømmet Andorra", "Fyrstedømmet Andorra", "Andorran ruhtinaskunta", "Княжество Андорра", "Andorra Prensliği", "Πριγκιπάτο της Ανδόρρας", "アンドラ公国", "安道尔公国", "안도라 공국", "إمارة أندورا", "Principat d'Andorra"},
+	"AF": {"Islamic Republic of Afghanistan", "Islamische Republik Afghanistan", "République islamique d'Afghanistan", "República Islámica de Afganistán", "Repubblica islamica dell'Afghanistan", "Islamitische Republiek Afghanistan", "República Islâmica do Afeganistão", "Islamska Republika Afganistanu", "Afghánistánská islámská republika", "Islamiska republiken Afghanistan", "Den Islamiske Republik Afghanistan", "Den islamske republikk Afghanistan", "Afganistanin islamilainen tasavalta", "Исламская Республика Афганистан", "Afganistan İslam Cumhuriyeti", "Ισλαμική Δημοκρατία του Αφγανιστάν", "アフガニスタン・イスラム共和国", "阿富汗伊斯兰共和国", "아프가니스탄 이슬람 공화국", "جمهوريّة أفغانستان الإسلاميّة", "افغانستان", "جمهوری اسلامی افغانستان"},
+	"AL": {"Republic of Albania", "Republik Albanien", "République d'Albanie", "República de Albania", "Repubblica d'Albania", "Republiek Albanië", "República da Albânia", "Republika Albanii", "Albánská republika", "Republiken Albanien", "Republikken Albanien", "Republikken Albania", "Albanian tasavalta", "Республика Албания", "Arnavutluk Cumhuriyeti", "Δημοκρατία της Αλβανίας", "アルバニア共和国", "阿尔巴尼亚共和国", "알바니아 공화국", "جمهوريّة ألبانيا"},
+	"AM": {"Republic of Armenia", "Republik Armenien", "République d'Arménie", "República de Armenia", "Repubblica d'Armenia", "Republiek Armenië", "República da Arménia", "Republika Armenii", "Arménská republika", "Republiken Armenien", "Republikken Armenien", "Republikken Armenia", "Armenian tasavalta", "Республика Армения", "Ermenistan Cumhuriyeti", "Δημοκρατία της Αρμενίας", "アルメニア共和国", "亚美尼亚共和国", "아르메니아 공화국", "جمهوريّة أرمينيا"},
+	"AO": {"Republic of Angola", "Republik Angola", "République d'Angola", "República de Angola", "Repubblica d'Angola", "Republiek Angola", "Republika Angoli", "Angolská republika", "Republiken Angola", "Republikken Angola", "Angolan tasavalta", "Республика Ангола", "Angola Cumhuriyeti", "Δημοκρατία της Ανγκόλα", "アンゴラ共和国", "安哥拉共和国", "앙골라 공화국", "جمهوريّة أنغولا"},
+	"AR": {"Argentine Republic", "Argentinische Republik", "République d'Argentine", "República Argentina", "Repubblica argentina", "Argentijnse Republiek", "Republika Argentyńska", "Argentinská republika", "Argentinska republiken", "Argentinske Republik", "Republikken Argentina", "Argentiinan tasavalta", "Аргентинская Республика", "Arjantin Cumhuriyeti", "Δημοκρατία της Αργεντινής", "アルゼンチン共和国", "阿根廷共和国", "아르헨티나 공화국", "الجمهوريّة الأرجنتينيّة"},
+	"AT": {"Republic of Austria", "Republik Österreich", "République d'Autriche", "República de Austria", "Repubblica d'Austria", "Republiek Oostenrijk", "República da Áustria", "Republika Austrii", "Rakouská republika", "Republiken Österrike", "Republikken Østrig", "Republikken Østerrike", "Itävallan tasavalta", "Австрийская Республика", "Avusturya Cumhuriyeti", "Αυστριακή Δημοκρατία", "オーストリア共和国", "奥地利共和国", "오스트리아 공화국", "جمهوريّة النّمسا"},
+	"AX": {"Åland, Îles"},
+	"AZ": {"Republic of Azerbaijan", "Republik Aserbaidschan", "République d'Azerbaïdjan", "República de Azerbaiyán", "Repubblica dell'Azerbaigian", "Republiek Azerbeidzjan", "República do Azerbaijão", "Republika Azerbejdżanu", "Ázerbájdžánská republika", "Republiken Azerbajdzjan", "Republikken Aserbajdsjan", "Azerbaidžanin tasavalta", "Республика Азербайджан", "Azerbaycan Cumhuriyeti", "Δημοκρατία του Αζερμπαϊτζάν", "アゼルバイジャン共和国", "阿塞拜疆共和国", "아제르바이잔 공화국", "جمهوريّة أذربيجان"},
+	"BA": {"Republic of Bosnia and Herzegovina", "République de Bosnie et Herzégovine", "República de Bosnia y Hercegovina", "Bosnia ed Erzegovina", "Republiek Bosnië en Herzegovina", "República da Bósnia-Herzegóvina", "Republika Bośni i Hercegowiny", "Republika Bosna a Hercegovina", "Republiken Bosnien-Hercegovina", "Republikken Bosnien-Herzegovina", "Republikken Bosnia-Hercegovina", "Bosnia-Hertsegovinan tasavalta", "Республика Босния и Герцеговина", "Bosna-Hersek Cumhuriyeti", "Δημοκρατία της Βοσνίας-Ερζεγοβίνης", "ボスニアヘルツコビナ共和国", "波斯尼亚和黑塞哥维那共和国", "보스니아 헤르체고비나 공화국", "جمهوريّة البوسنة و الهرسك", "Bosna i Hercegovina", "Republika Bosna i Hercegovina", "Босна и Херцеговина", "Република Босна и Херцеговина"},
+	"BD": {"People's Republic of Bangladesh", "Volksrepublik Bangladesh", "République populaire du Bengladesh", "República Popular de Bangladés", "Repubblica Popolare del Bangladesh", "Volksrepubliek Bangladesh", "República Popular do Bangladeche", "Ludowa Republika Bangladeszu", "Bangladéšská lidová republika", "Folkrepubliken Bangladesh", "Folkerepublikken Bangladesh", "Bangladeshin kansantasavalta", "Народная Республика Бангладеш", "Bangladeş Halk Cumhuriyeti", "Λαϊκή Δημοκρατία του Μπανγκλαντές", "バングラデシュ人民共和国", "孟加拉人民共和国", "방글라데시 인민 공화국", "جمهوريّة بنغلادش الشّعبيّة"},
+	"BE": {"Kingdom of Belgium", "Königreich Belgien", "Royaume de Belgique", "Reino de Bélgica", "Regno del Belgio", "Koninkrijk België", "Reino da Bélgica", "Królestwo Belgii", "Belgické království", "Konungariket Belgien", "Kongeriget Belgien", "Kongeriket Belgia", "Belgian kuningaskunta", "Королевство Бельгия", "Belçika Krallığı", "Βασίλειο του Βελγίου", "ベルギー王国", "比利时王国", "벨기에 왕국", "مملكة بلجيكا"},
+	"BG": {"Republic of Bulgaria", "Republik Bulgarien", "République de Bulgarie", "República de Bulgaria", "Repubblica di Bulgaria", "Republiek Bulgarije", "República da Bulgária", "Republika Bułgarii", "Bulharská republika", "Republiken Bulgarien", "Republikken Bulgarien", "Republikken Bulgaria", "Bulgarian tasavalta", "Республика Болгария", "Bulgaristan Cumhuriyeti", "Δημοκρατία της Βουλγαρίας", "ブルガリア共和国", "保加利亚共和国", "불가리아 공화국", "جمهوريّة بلغاريا", "България", "Република България"},
+	"BH": {"Kingdom of Bahrain", "Königreich Bahrain", "Royaume de Bahreïn", "Reino de Baréin", "Regno del Bahrein", "Koninkrijk Bahrein", "Reino do Barém", "Królestwo Bahrajnu", "Bahrajnské království", "Konungariket Bahrain", "Kongedømmet Bahrain", "Bahrainin kuningaskunta", "Королевство Бахрейн", "Bahreyn Krallığı", "Βασίλειο του Μπαχρέιν", "バーレーン王国", "巴林王国", "바레인 왕국", "مملكة البحرين"},
+	"BI": {"Republic of Burundi", "Republik Burundi", "République du Burundi", "República de Burundi", "Repubblica del Burundi", "Republiek Burundi", "República do Burundi", "Republika Burundi", "Burundská republika", "Republiken Burundi", "Republikken Burundi", "Burindin tasavalta", "Республика Бурунди", "Burundi Cumhuriyeti", "Δημοκρατία του Μπουρούντι", "ブルンジ共和国", "布隆迪共和国", "부룬디 공화국", "جمهوريّة بوروندي"},
+	"BJ": {"Republic of Benin", "Republik Benin", "République du Bénin", "República de Benín", "Repubblica del Benin", "Republiek Benin", "República do Benim", "Republika Beninu", "Beninská republika", "Republiken Benin", "Republikken Benin", "Beninin tasavalta", "Республика Бенин", "Benin Cumhuriyeti", "Δημοκρατία του Μπενίν", "ベナン共和国", "贝宁共和国", "베냉 공화국", "جمهوريّة بنين"},
+	"BN": {"Brunei Darussalam"},
+	"BO": {"Bolivia, Plurinational State of", "Plurinational State of Bolivia", "Bolivien, Plurinationaler Staat", "Plurinationaler Staat Bolivien", "Bolivie, état plurinational de", "État plurinational de Bolivie", "Bolivia, Estado plurinacional de", "Estado plurinacional de Bolivia", "Bolivia, Stato Plurinazionale della", "Stato Plurinazionale della Bolivia", "Bolivia, Multinationale Staat", "Multinationale Staat Bolivia", "Bolívia, Estado Plurinacional da", "Estado Plurinacional da Bolívia", "Boliwia - Wielonarodowe Państwo", "Wielonarodowe Państwo Boliwii", "Mnohonárodní stát Bolívie", "Bolivia, Mångnationella staten", "Mångnationella staten Bolivia", "Bolivia, Den Plurinationale Stat", "Den Plurinationale Stat Bolivia", "Bolivia, den flernasjonale stat", "Den flernasjonale stat Bolivia", "Bolivia, monikansallinen valtio", "Bolivian monikansallinen valtio", "Многонациональное Государство Боливия", "Bolivya Çokuluslu Devleti", "Βολιβία, Πολυεθνική Πολιτεία της", "Πολυεθνική Πολιτεία της Βολιβίας", "ボリビア多民族国", "玻利维亚共和国", "볼리비아 다국가 연합국", "جمهورية بوليفيا"},
+	"BQ": {"Bonaire, Sint Eustatius and Saba", "Bonaire, Sint Eustatius und Saba", "Bonaire, Saint-Eustache et Saba", "Bonaire, Sint Eustatius en Saba", "Bonaire, Santo Eustáquio e Saba", "Bonaire, Sint Eustatius i Saba", "Bonaire, Svatý Eustach a Saba", "Bonaire, Sint Eustatius och Saba", "Bonaire, Sint Eustatius og Saba", "Bonaire, Sint Eustatius ja Saba", "Бонайре, Синт-Эстатиус и Саба", "Bonaire, Sint Eustatius ve Saba", "Μποναίρ, Άγιος Ευστράτιος και Σάμπα", "보네르, 신트외스타티위스, 사바 섬"},
+	"BR": {"Federative Republic of Brazil", "Föderative Republik Brasilien", "République fédérale du Brésil", "República Federativa de Brasil", "Repubblica Federale del Brasile", "Federale Republiek Brazilië", "República Federativa do Brasil", "Federacyjna Republika Brazylii", "Brazilská federativní republika", "Federala republiken Brasilien", "Den Føderative Republik Brasilien", "Forbundsrepublikken Brasil", "Brasilian liittotasavalta", "Федеративная Республика Бразилия", "Brezilya Federal Cumhuriyeti", "Ομόσπονδη Δημοκρατία της Βραζιλίας", "ブラジル連邦共和国", "巴西联邦共和国", "브라질 연방 공화국", "جمهوريّة البرازيل الاتّحاديّة"},
+	"BS": {"Commonwealth of the Bahamas", "Commonwealth der Bahamas", "Commonwealth des Bahamas", "Commonwealth de las Bahamas", "Commonwealth delle Bahamas", "Gemenebest van de Bahama's", "Comunidade das Bahamas", "Wspólnota Bahamów", "Bahamské společenství", "Samväldet Bahamas", "Bahamassambandet", "Bahaman liittovaltio", "Содружество Багамских Островов", "Bahamalar Milletler Topluluğu", "Κοινοπολιτεία των Μπαχαμών", "バハマ国", "巴哈马国", "바하마 연방", "كومنولث البهاما"},
+	"BT": {"Kingdom of Bhutan", "Königreich Bhutan", "Royaume du Bouthan", "Reino de Bután", "Regno del Bhutan", "Koninkrijk Bhutan", "Reino do Butão", "Królestwo Bhutanu", "Bhútánské království", "Konungariket Bhutan", "Kongedømmet Bhutan", "Kongeriket Bhutan", "Bhutanin kuningaskunta", "Королевство Бутан", "Butan Krallığı", "Βασίλειο του Μπουτάν", "ブータン王国", "不丹王国", "부탄 왕국", "مملكة بوتان"},
+	"BW": {"Republic of Botswana", "Republik Botsuana", "République du Botswana", "República de Botsuana", "Repubblica del Botswana", "Republiek Botswana", "República do Botsuana", "Republika Botswany", "Botswanská republika", "Republiken Botswana", "Republikken Botswana", "Botswanan tasavalta", "Республика Ботсвана", "Botsvana Cumhuriyeti", "Δημοκρατία της Μποτσουάνας", "ボツワナ共和国", "博兹瓦那共和国", "보츠와나 공화국", "جمهوريّة بوتسوانا"},
+	"BY": {"Republic of Belarus", "Republik Belarus", "République du Bélarus", "República de Bielorrusia", "Repubblica di Bielorussia", "Republiek Belarus", "República da Bielorússia", "Republika Białorusi", "Běloruská republika", "Republiken Vitryssland", "Republikken Hviderusland", "Republikken Hviterussland", "Valko-Venäjän tasavalta", "Республика Беларусь", "Belarus Cumhuriyeti", "Δημοκρατία της Λευκορωσίας", "ベラルーシ共和国", "白俄罗斯共和国", "벨라루스 공화국", "جمهوريّة روسيا البيضاء"},
+	"CC": {"Cocos (Keeling), Îles"},
+	"CD": {"Congo, The Democratic Republic of the", "Congo, República Democrática del", "Congo, Democratische Republiek", "Congo, República Democrática do", "Kongo, Demokratyczna Republika Konga", "Kongo, demokratiska republiken", "Kongo, Den demokratiske republikk", "Κονγκό, Λαϊκή Δημοκρατία του"},
+	"CG": {"Congo", "Republik Kongo", "República del Congo", "Repubblica del Congo", "Republiek Congo", "República do Congo", "Republika Konga", "Konžská republika", "Republiken Kongo", "Republikken Congo", "Republikken Kongo", "Kongon tasavalta", "Республика Конго", "Kongo Cumhuriyeti", "Δημοκρατία του Κονγκό", "コンゴ共和国", "刚果共和国", "콩고 공화국", "جمهوريّة الكونغو"},
+	"CH": {"Swiss Confederation", "Schweizerische Eidgenossenschaft", "Confédération helvétique", "Confederación Suiza", "Confederazione svizzera", "Zwitserse Bondsstaat", "Confederação Suíça", "Konfederacja Szwajcarska", "Švýcarská konfederace", "Schweiziska konfederationen", "Det Schweiziske Forbund", "Det sveitsiske edsforbund", "Sveitsin valaliitto", "Швейцарская Конфедерация", "İsviçre Konfederasyonu", "Ελβετική Συνομοσπονδία", "スイス連邦", "瑞士联邦", "스위스 연방", "الاتّحاد السّويسري"},
+	"CI": {"Republic of Côte d'Ivoire", "Republik Côte d'Ivoire", "République de Côte d'Ivoire", "República de Costa de Marfíl", "Repubblica della Costa d'Avorio", "Republiek Ivoorkust", "República da Costa do Marfim", "Republika Wybrzeża Kości Słoniowej", "Republika Pobřeží slonoviny", "Republiken Elfenbenskusten", "Republikken Elfenbenskysten", "Norsunluurannikon tasavalta", "Республика Кот-д'Ивуар", "Fildişi Sahili Cumhuriyeti", "Δημοκρατία της Ακτής του Ελεφαντοστού", "コートジボワール共和国", "科特迪瓦共和国", "코트디부아르 공화국", "جمهوريّة ساحل العاج"},
+	"CL": {"Republic of Chile", "Republik Chile", "République du Chili", "República de Chile", "Repubblica del Cile", "Republiek Chili", "República do Chile", "Republika Chile", "Chilská republika", "Republiken Chile", "Republikken Chile", "Chilen tasavalta", "Республика Чили", "Şili Cumhuriyeti", "Δημοκρατία της Χιλής", "チリ共和国", "智利共和国", "칠레 공화국", "جمهوريّة تشيلي"},
+	"CM": {"Republic of Cameroon", "Republik Kamerun", "République du Cameroun", "República del Camerún", "Repubblica del Camerun", "Republiek Kameroen", "República dos Camarões", "Republika Kamerunu", "Kamerunská republika", "Republiken Kamerun", "Republikken Cameroon", "Republikken Kamerun", "Kamerunin tasavalta", "Республика Камерун", "Kamerun Cumhuriyeti", "Δημοκρατία του Καμερούν", "カメルーン共和国", "喀麦隆共和国", "카메룬 공화국", "جمهوريّة الكاميرون"},
+	"CN": {"People's Republic of China", "Volksrepublik China", "République populaire de Chine", "República Popular China", "Repubblica Popolare Cinese", "Volksrepubliek China", "República Popular da China", "Chińska Republika Ludowa", "Čínská lidová republika", "Folkrepubliken Kina", "Folkerepublikken Kina", "Kiinan kansantasavalta", "Китайская Народная Республика", "Çin Halk Cumhuriyeti", "Λαϊκή Δημοκρατία της Κίνας", "中華人民共和国", "中华人民共和国", "중화인민공화국", "جمهوريّة الصّين الشّعبيّة"},
+	"CO": {"Republic of Colombia", "Republik Kolumbien", "République de Colombie", "República de Colombia", "Repubblica di Colombia", "Republiek Colombia", "República da Colômbia", "Republika Kolumbii", "Kolumbijská republika", "Republiken Colombia", "Republikken Colombia", "Kolumbian tasavalta", "Республика Колумбия", "Kolombiya Cumhuriyeti", "Δημοκρατία της Κολομβίας", "コロンビア共和国", "哥伦比亚共和国", "콜롬비아 공화국", "جمهوريّة كولومبيا"},
+	"CR": {"Republic of Costa Rica", "Republik Costa Rica", "République du Costa Rica", "República de Costa Rica", "Repubblica di Costa Rica", "Republiek Costa Rica", "República da Costa Rica", "Republika Kostaryki", "Kostarická republika", "Republiken Costa Rica", "Republikken Costa Rica", "Costa Rican tasavalta", "Республика Коста-Рика", "Kosta Rika Cumhuriyeti", "Δημοκρατία της Κόστα Ρίκα", "コスタリカ共和国", "哥斯达黎加共和国", "코스타리카 공화국", "جمهوريّة كوستاريكا"},
+	"CU": {"Republic of Cuba", "Republik Kuba", "République de Cuba", "República de Cuba", "Repubblica di Cuba", "Republiek Cuba", "Republika Kuby", "Kubánská republika", "Republiken Kuba", "Republikken Cuba", "Kuuban tasavalta", "Республика Куба", "Küba Cumhuriyeti", "Δημοκρατία της Κούβας", "キューバ共和国", "古巴共和国", "쿠바 공화국", "جمهوريّة كوبا"},
+	"CV": {"Cabo Verde", "Republic of Cabo Verde", "Republik Kap Verde", "République du Cap-Vert", "República de Cabo Verde", "Repubblica di Capo Verde", "Republiek Kaapverdië", "Kapverdská republika", "Republiken Kap Verde", "Republikken Kap Verde", "Republikken Kapp Verde", "Республика Кабо-Верде", "Yeşil Burun Cumhuriyeti", "Δημοκρατία του Πράσινου Ακρωτηρίου", "カーボヴェルデ共和国", "佛得角共和国", "카보베르데 공화국", "جمهورية الرأس الأخضر"},
+	"CX": {"Christmas, Île"},
+	"CY": {"Republic of Cyprus", "Republik Zypern", "République de Chypre", "República de Chipre", "Repubblica di Cipro", "Republiek Cyprus", "Republika Cypru", "Kyperská republika", "Republiken Cypern", "Republikken Cypern", "Republikken Kypros", "Kyproksen tasavalta", "Республика Кипр", "Kıbrıs Cumhuriyeti", "Κυπριακή Δημοκρατία", "キプロス共和国", "塞浦路斯共和国", "키프로스 공화국", "جمهوريّة قبرص"},
+	"CZ": {"Czech Republic", "Tschechische Republik", "République tchèque", "República Checa", "Repubblica Ceca", "Republika Czeska", "Česká republika", "Den tsjekkiske republikk", "Tšekin tasavalta", "Чешская Республика", "Çek Cumhuriyeti", "Τσεχική Δημοκρατία", "チェコ共和国", "체코 공화국", "جمهوريّة التّشيك"},
+	"DE": {"Federal Republic of Germany", "Bundesrepublik Deutschland", "République fédérale d'Allemagne", "República Federal de Alemania", "Repubblica Federale di Germania", "Bondsrepubliek Duitsland", "República Federal da Alemanha", "Republika Federalna Niemiec", "Spolková republika Německo", "Förbundsrepubliken Tyskland", "Forbundsrepublikken Tyskland", "Saksan liittotasavalta", "Федеративная Республика Германия", "Almanya Federal Cumhuriyeti", "Ομοσπονδιακή Δημοκρατία της Γερμανίας", "ドイツ連邦共和国", "德意志联邦共和国", "독일 연방 공화국", "جمهوريّة ألمانيا الاتّحاديّة"},
+	"DJ": {"Republic of Djibouti", "Republik Dschibuti", "République de Djibouti", "República de Yibuti", "Repubblica di Gibuti", "Republiek Djibouti", "República do Djibouti", "Republika Dżibuti", "Džibutská republika", "Republiken Djibouti", "Republikken Djibouti", "Djiboutin tasavalta", "Республика Джибути", "Cibuti Cumhuriyeti", "Δημοκρατία του Τζιμπουτί", "ジブチ共和国", "吉布提共和国", "지부티 공화국", "جمهوريّة جيبوتي"},
+	"DK": {"Kingdom of Denmark", "Königreich Dänemark", "Royaume du Danemark", "Reino de Dinamarca", "Regno di Danimarca", "Koninkrijk Denemarken", "Reino da Dinamarca", "Królestwo Danii", "Dánské království", "Konungariket Danmark", "Kongeriget Danmark", "Kongeriket Danmark", "Tanskan kuningaskunta", "Королевство Дания", "Danimarka Krallığı", "Βασίλειο της Δανίας", "デンマーク王国", "丹麦王国", "덴마크 왕국", "مملكة الدّنمارك"},
+	"DM": {"Commonwealth of Dominica", "Commonwealth Dominica", "Commonwealth de la Dominique", "Commonwealth de Dominica", "Commonwealth di Dominica", "Gemenebest van Dominica", "Comunidade da Dominica", "Wspólnota Dominiki", "Dominické společenství", "Samväldet Dominica", "Samveldet Dominica", "Dominican liittovaltio", "Содружество Доминики", "Dominik Milletler Topluluğu", "Κοινοπολιτεία της Ντομίνικα", "ドミニカ国", "米尼克共和国", "كومنولث دومينيكا"},
+	"DZ": {"People's Democratic Republic of Algeria", "Demokratische Volksrepublik Algerien", "République algérienne démocratique et populaire", "República Democrática Popular de Argelia", "Repubblica Democratica Popolare di Algeria", "Democratische Volksrepubliek Algerije", "República Democrática e Popular da Argélia", "Algierska Republika Ludowo-Demokratyczna", "Alžírská lidová demokratická republika", "Demokratiska folkrepubliken Algeriet", "Den Demokratiske Folkerepublik Algeriet", "Den demokratiske folkerepublikk Algerie", "Algerian demokraattinen kansantasavalta", "Алжирская Народная Демократическая Республика", "Cezayir Demokratik Halk Cumhuriyeti", "Λαϊκη Δημοκρατία της Αλγερίας", "アルジェリア民主人民共和国", "阿尔及利亚人民民主共和国", "알제리 인민 민주주의 공화국", "الجمهورية الجزائرية الديمقراطية الشعبية"},
+	"EC": {"Republic of Ecuador", "Republik Ecuador", "République d'Équateur", "República del Ecuador", "Repubblica dell'Ecuador", "Republiek Ecuador", "República do Equador", "Republika Ekwadoru", "Ekvádoská republika", "Republiken Ecuador", "Republikken Ecuador", "Ecuadorin tasavalta", "Республика Эквадор", "Ekvator Cumhuriyeti", "Δημοκρατία του Ισημερινού", "エクアドル共和国", "厄瓜多尔共和国", "에콰도르 공화국", "جمهوريّة الإكوادور"},
+	"EE": {"Republic of Estonia", "Republik Estland", "République d'Estonie", "República de Estonia", "Repubblica d'Estonia", "Republiek Estland", "República da Estónia", "Republika Estonii", "Estonská republika", "Republiken Estland", "Republikken Estland", "Viron tasavalta", "Эстонская Республика", "Estonya Cumhuriyeti", "Δημοκρατία της Εσθονίας", "エストニア共和国", "爱沙尼亚共和国", "에스토니아 공화국", "جمهوريّة إستونيا", "Eesti", "Eesti Vabariik"},
+	"EG": {"Arab Republic of Egypt", "Arabische Republik Ägypten", "République arabe d'Égypte", "República Árabe de Egipto", "Repubblica araba d'Egitto", "Arabische Republiek Egypte", "República Árabe do Egito", "Egipska Republika Arabska", "Egyptská arabská republika", "Arabiska republiken Egypten", "Den Arabiske Republik Egypten", "Den arabiske republikk Egypt", "Egyptin arabitasavalta", "Арабская Республика Египет", "Mısır Arap Cumhuriyeti", "Αραβική Δημοκρατία της Αιγύπτου", "エジプト・アラブ共和国", "阿拉伯埃及共和国", "이집트 아랍 공화국", "جمهوريّة مصر العربيّة"},
+	"ER": {"the State of Eritrea", "Staat Eritrea", "l'État d'Érythrée", "Estado de Eritrea", "Repubblica dell'Eritrea", "Estados da Eritreia", "Państwo Erytrea", "Stát Eritrea", "Staten Eritrea", "Государство Эритрея", "Eritre Devleti", "Κράτος της Ερυθραίας", "厄立特里亚国", "에리트레아 공화국", "دولة إريتريا"},
+	"ES": {"Kingdom of Spain", "Königreich Spanien", "Royaume d'Espagne", "Reino de España", "Regno di Spagna", "Koninkrijk Spanje", "Reino de Espanha", "Królestwo Hiszpanii", "Španělské království", "Konungariket Spanien", "Kongeriget Spanien", "Kongeriket Spania", "Espanjan kuningaskunta", "Королевство Испания", "İspanya Krallığı", "Βασίλειο της Ισπανίας", "スペイン王国", "西班牙王国", "스페인 왕국", "مملكة إسبانيا"},
+	"ET": {"Federal Democratic Republic of Ethiopia", "Demokratische Bundesrepublik Äthiopien", "République fédérale démocratique d'Éthiopie", "República Federal Democrática de Etiopía", "Repubblica Federale Democratica d'Etiopia", "Federale Democratische Republiek Ethiopië", "República Democrática Federal da Etiópia", "Etiopska Republika Ludowo-Demokratyczna", "Etiopská federativní demokratická republika", "Demokratiska förbundsrepubliken Etiopien", "Den Føderative Demokratiske Republik Etiopien", "Den føderale demokratiske republikk Etiopia", "Etiopian demokraattinen liittotasavalta", "Федеративная Демократическая Республика Эфиопия", "Etiyopya Federal Demokratik Cumhuriyeti", "Ομοσπονδιακή Λαϊκή Δημοκρατία της Αιθιοπίας", "エチオピア連邦民主共和国", "埃塞俄比亚联邦民主共和国", "에티오피아 연방 민주 공화국", "جمهوريّة إثيوبيا الدّيموقراطيّة الاتّحاديّة"},
+	"FI": {"Republic of Finland", "Republik Finnland", "République de Finlande", "República de Finlandia", "Repubblica di Finlandia", "Republiek Finland", "República da Finlândia", "Republika Finlandii", "Finská republika", "Republiken Finland", "Republikken Finland", "Suomen tasavalta", "Финляндская Республика", "Finlandiya Cumhuriyeti", "Δημοκρατία της Φινλανδίας", "フィンランド共和国", "芬兰共和国", "핀란드 공화국", "جمهوريّة فنلندا"},
+	"FJ": {"Republic of Fiji", "Republik Fidschi", "République des Fidji", "República de Fiyi", "Repubblica di Figi", "Republiek Fiji", "República das Fiji", "Republika Fidżi", "Fidžijská republika", "Republiken Fiji", "Republikken Fiji", "Republikken Fijiøyene", "Fidžin tasavalta", "Республика Фиджи", "Fiji Cumhuriyeti", "Δημοκρατία των Φίντζι", "フィジー共和国", "斐济共和国", "피지 공화국", "جمهورية فيجي"},
+	"FK": {"Falkland Islands (Malvinas)", "Malouines, Îles (Falkland)"},
+	"FM": {"Micronesia, Federated States of", "Federated States of Micronesia", "Mikronesien, Föderierte Staaten von", "Föderierte Staaten von Mikronesien", "Micronésie, États fédérés de", "États fédérés de Micronésie", "Micronesia, Estados Federados de", "Estados Federados de Micronesia", "Stati federati di Micronesia", "Federale Staten van Micronesia", "Micronésia, Estados Federados da", "Estados Federados da Micronésia", "Sfederowane Stany Mikronezji", "Mikronésie, federativní státy", "Federativní státy Mikronésie", "Mikronesien, federala staterna", "Federala staterna Mikronesien", "Mikronesia, Føderasjonen", "Mikronesiaføderasjonen", "Μικρονησία, Ομόσπονδες Πολιτείες της", "Ομόσπονδες Πολιτείες της Μικρονησίας", "密克罗尼西亚联邦", "ولايات ميكرونيزيا الموحّدة"},
+	"FR": {"French Republic", "Französische Republik", "République française", "República Francesa", "Repubblica francese", "Franse Republiek", "Republika Francji", "Francouzská republika", "Franska republiken", "Den Franske Republik", "Republikken Frankrike", "Ranskan tasavalta", "Французская Республика", "Fransa Cumhuriyeti", "Γαλλική Δημοκρατία", "フランス共和国", "法兰西共和国", "프랑스 공화국", "جمهوريّة الفرنسيّة"},
+	"GA": {"Gabonese Republic", "Gabunische Republik", "République gabonaise", "República Gabonesa", "Repubblica Gabonese", "Republiek Gabon", "Republika Gabońska", "Gabonská republika", "Gabonesiska republiken", "Den Gabonesiske Republik", "Republikken Gabon", "Gabonin tasavalta", "Габонская Республика", "Gabon Cumhuriyeti", "Δημοκρατία της Γκαμπόν", "ガボン共和国", "加蓬共和国", "가봉 공화국", "الجمهوريّة الغابونيّة"},
+	"GB": {"United Kingdom of Great Britain and Northern Ireland", "Vereinigtes Königreich Großbritannien und Nordirland", "Royaume-Uni de Grande-Bretagne et d'Irlande du Nord", "Reino Unido de Gran Bretaña e Irlanda del Norte", "Regno Unito di Gran Bretagna e d'Irlanda del Nord", "Verenigd Koninkrijk van Groot-Brittannië en Noord-Ierland", "Reino Unido da Grã-Bretanha e Irlanda do Norte", "Zjednoczone Królestwo Wielkiej Brytanii i Irlandii Północnej", "Spojené království Velké Británie a Severního Irska", "Förenade kungariket Storbritannien och Nordirland", "Det Forenede Kongerige Storbritannien og Nordirland", "Det forente kongeriket Storbritannia og Nord-Irland", "Ison-Britannian ja Pohjois-Irlannin yhdistynyt kuningaskunta", "Соединённое Королевство Великобритании и Северной Ирландии", "Büyük Britanya ve Kuzey İrlanda Birleşik Krallığı", "Ηνωμένο Βασίλειο της Μεγάλης Βρετανίας και της Βόρειας Ιρλανδίας", "グレートブリテン及び北アイルランド連合王国", "大不列颠及北爱尔兰联合王国", "대영제국", "المملكة المتّحدة لبريطانيا العظمى و أيرلندا الشّماليّة"},
+	"GH": {"Republic of Ghana", "Republik Ghana", "République du Ghana", "República de Ghana", "Repubblica del Ghana", "Republiek Ghana", "República do Gana", "Republika Ghany", "Ghanská republika", "Republiken Ghana", "Republikken Ghana", "Ghanan tasavalta", "Республика Гана", "Gana Cumhuriyeti", "Δημοκρατία της Γκάνας", "ガーナ共和国", "加纳共和国", "가나 공화국", "جمهوريّة غانا"},
+	"GM": {"Republic of the Gambia", "Republik Gambia", "République de Gambie", "República de Gambia", "Repubblica del Gambia", "Republiek Gambia", "República da Gâmbia", "Republika Gambii", "Gambijská republika", "Republiken Gambia", "Republikken Gambia", "Республика Гамбия", "Gambiya Cumhuriyeti", "Δημοκρατία της Γκάμπια", "冈比亚共和国", "감비아 공화국", "جمهورية غامبيا"},
+	"GN": {"Republic of Guinea", "Republik Guinea", "République de Guinée", "República de Guinea", "Repubblica di Guinea", "Republiek Guinee", "República da Guiné", "Republika Gwinei", "Guinejská republika", "Republiken Guinea", "Republikken Guinea", "Guinean tasavalta", "Гвинейская Республика", "Gine Cumhuriyeti", "Δημοκρατία της Γουινέας", "ギニア共和国", "几内亚共和国", "기니 공화국", "جمهوريّة غينيا"},
+	"GQ": {"Republic of Equatorial Guinea", "Republik Äquatorialguinea", "République de Guinée Équatoriale", "República de Guinea Ecuatorial", "Repubblica della Guinea Equatoriale", "Republiek Equatoriaal-Guinea", "República da Guiné Equatorial", "Republika Gwinei Równikowej", "Republika Rovníková Guinea", "Republiken Ekvatorialguinea", "Republikken Ækvatorialguinea", "Republikken Ekvatorial-Guinea", "Päiväntasaajan Guinean tasavalta", "Республика Экваториальная Гвинея", "Ekvatoral Gine Cumhuriyeti", "Δημοκρατία της Ισημερινής Γουινέας", "赤道ギニア共和国", "赤道几内亚共和国", "적도 기니 공화국", "جمهوريّة غينيا الاستوائيّة"},
+	"GR": {"Hellenic Republic", "Hellenische Republik", "République grecque", "República Helénica", "Repubblica Ellenica", "Helleense Republiek", "Republika Grecka", "Řecká republika", "Hellenska republiken", "Den Hellenske Republik", "Republikken Hellas", "Helleenien tasavalta", "Греческая Республика", "Yunanistan Cumhuriyeti", "Ελληνική Δημοκρατία", "ギリシア共和国", "希腊共和国", "그리스 공화국", "الجمهوريّة الهلنستيّة"},
+	"GT": {"Republic of Guatemala", "Republik Guatemala", "République du Guatemala", "República de Guatemala", "Repubblica del Guatemala", "Republiek Guatemala", "República da Guatemala", "Republika Gwatemali", "Guatemalská republika", "Republiken Guatemala", "Republikken Guatemala", "Guatemalan tasavalta", "Республика Гватемала", "Guatemala Cumhuriyeti", "Δημοκρατία της Γουατεμάλας", "グアテマラ共和国", "瓜地马拉共和国", "과테말라 공화국", "جمهوريّة غواتيمالا"},
+	"GW": {"Republic of Guinea-Bissau", "Republik Guinea-Bissau", "République de Guinée-Bissau", "República de Guinea-Bissau", "Repubblica di Guinea-Bissau", "Republiek Guinee-Bissau", "República da Guiné-Bissáu", "Republika Gwinei Bissau", "Republika Guinea-Bissau", "Republiken Guinea-Bissau", "Republikken Guinea-Bissau", "Guinea-Bissaun tasasvalta", "Республика Гвинея-Бисау", "Gine-Bissau Cumhuriyeti", "Δημοκρατία της Γουινέας-Μπισσάου", "ギニアビサウ共和国", "几内亚比绍共和国", "기니비사우 공화국", "جمهوريّة غينيا بيساو"},
+	"GY": {"Republic of Guyana", "Kooperative Republik Guyana", "République de Guyana", "República de Guyana", "Repubblica Cooperativa di Guyana", "Republiek Guyana", "República da Guiana", "Republika Gujany", "Guyanská republika", "Republiken Guyana", "Republikken Guyana", "Den kooperative republikk Guyana", "Guyanan tasavalta", "Республика Гайана", "Guyana Cumhuriyeti", "Δημοκρατία της Γουιάνας", "ガイアナ共和国", "圭亚那共和国", "가이아나 공화국", "جمهوريّة غويانا"},
+	"HK": {"Hong Kong Special Administrative Region of China", "Sonderverwaltungsregion Hongkong", "Région spéciale administrative chinoise de Hong-Kong", "Región Administrativa Especial China de Hong Kong", "Regione amministrativa speciale di Hong Kong della Repubblica Popolare Cinese", "Speciale Administratieve Regio Hongkong van de Volksrepubliek China", "Hong Kong, Região de Administração Especial da China", "Hongkong - Specjalny Region Administracyjny Chińskiej Republiki Ludowej", "Hongkong, zvláštní administrativní oblast Číny", "Särskilda administrativa regionen Hong Kong inom Kina", "Det Særlige Administrative Område Hongkong", "Hong Kong spesielle administrative region av Kina", "Kiinan kansantasavallan erityishallintoalue Hong Kong", "Осо́бый административный район Гонконг", "Çin Halk Cumhuriyeti Hong Kong Özel İdari Bölgesi", "Χονγκ Κονγκ Ειδική Διοικητική Περιοχή της Κίνας", "香港・中国特別行政区", "中国香港特别行政区", "홍콩, 중국의 특별 행정구", "هونج كونج المنطقة الصّينيّة الإداريّة الخاصّة"},
+	"HN": {"Republic of Honduras", "Republik Honduras", "République du Honduras", "República de Honduras", "Repubblica dell'Honduras", "Republiek Honduras", "República das Honduras", "Republika Hondurasu", "Honduraská republika", "Republiken Honduras", "Republikken Honduras", "Hondurasin tasavalta", "Республика Гондурас", "Honduras Cumhuriyeti", "Δημοκρατία της Ονδούρας", "ホンジュラス共和国", "洪都拉斯共和国", "온두라스 공화국", "جمهوريّة هندوراس"},
+	"HR": {"Republic of Croatia", "Republik Kroatien", "République de Croatie", "República de Croacia", "Repubblica di Croazia", "Republiek Kroatië", "República da Croácia", "Republika Chorwacji", "Chorvatská republika", "Republiken Kroatien", "Republikken Kroatien", "Republikken Kroatia", "Kroatian tasavalta", "Республика Хорватия", "Hırvatistan Cumhuriyeti", "Δημοκρατία της Κροατίας", "クロアチア共和国", "克罗地亚共和国", "크로아티아 공화국", "جمهوريّة كرواتيا", "Hrvatska", "Republika Hrvatska"},
+	"HT": {"Republic of Haiti", "Republik Haiti", "République de Haïti", "República de Haití", "Repubblica di Haiti", "Republiek Haïti", "República do Haiti", "Republika Haiti", "Haitská republika", "Republiken Haiti", "Republikken Haiti", "Haitin tasavalta", "Республика Гаити", "Haiti Cumhuriyeti", "Δημοκρατία της Αϊτής", "ハイチ共和国", "海地共和国", "아이티 공화국", "جمهوريّة هايتي"},
+	"HU": {"Magyarország"},
+	"ID": {"Republic of Indonesia", "Republik Indonesien", "République d'Indonésie", "República de Indonesia", "Repubblica d'Indonesia", "Republiek Indonesië", "República da Indonésia", "Republika Indonezji", "Indonéská republika", "Republiken Indonesien", "Republikken Indonesien", "Republikken Indonesia", "Indonesian tasavalta", "Республика Индонезия", "Endonezya Cumhuriyeti", "Δημοκρατία της Ινδονησίας", "インドネシア共和国", "印度尼西亚共和国", "인도네시아 공화국", "جمهوريّة اندونيسيا", "Negara Kesatuan Republik Indonesia"},
+	"IE": {"Éire"},
+	"IL": {"State of Israel", "Staat Israel", "État d'Israël", "Estado de Israel", "Stato d'Israele", "Staat Israël", "Państwo Izrael", "Stát Izrael", "Staten Israel", "Israelin valtio", "Государство Израиль", "İsrail Devleti", "Κράτος του Ισραήλ", "イスラエル国", "以色列国", "دولة إسرائيل", "ישראל", "מדינת ישראל"},
+	"IN": {"Republic of India", "Republik Indien", "République d'Inde", "República de la India", "Repubblica dell'India", "Republiek India", "República da Índia", "Republika Indii", "Indická republika", "Republiken Indien", "Republikken Indien", "Republikken India", "Intian tasavalta", "Республика Индия", "Hindistan Cumhuriyeti", "Δημοκρατία της Ινδίας", "インド共和国", "印度共和国", "인도 공화국", "جمهوريّة الهند", "भारत", "भारतीय गणराज्य"},
+	"IQ": {"Republic of Iraq", "Republik Irak", "République d'Iraq", "República de Irak", "Repubblica d'Iraq", "Republiek Irak", "República do Iraque", "Republika Iracka", "Irácká republika", "Republiken Irak", "Republikken Irak", "Irakin tasavalta", "Иракская Республика", "Irak Cumhuriyeti", "Δημοκρατία του Ιράκ", "イラク共和国", "伊拉克共和国", "이라크 공화국", "جمهوريّة العراق"},
+	"IR": {"Iran, Islamic Republic of", "Islamic Republic of Iran", "Iran, Islamische Republik", "Islamische Republik Iran", "Iran, République islamique d'", "République islamique d'Iran", "Irán, República islámica de", "República Islámica de Irán", "Repubblica Islamica dell'Iran", "Islamitische Republiek Iran", "Irão, República Islâmica do", "República Islâmica do Irão", "Iran, Islamska Republika", "Islamska Republika Iranu", "Írán, islámská republika", "Íránská islámská republika", "Iran, islamiska republiken", "Islamiska republiken Iran", "Iran, Den Islamiske Republik", "Den Islamiske Republik Iran", "Iran, Den islamske republikk", "Den islamske republikk Iran", "Iranin islamilainen tasavalta", "Иран", "Исламская Респу́блика Иран", "İran İslâm Cumhuriyeti", "Ιράν, Ισλαμική Δημοκρατία του", "Ισλαμική Δημοκρατία του Ιράν", "イラン・イスラム共和国", "伊朗伊斯兰共和国", "이란 이슬람 공화국", "إيران، الجمهوريّة الإسلاميّة الإيرانيّة", "الجمهورية الإسلاميّة الإيرانيّة", "جمهوری اسلامی ایران"},
+	"IS": {"Republic of Iceland", "Republik Island", "République d'Islande", "República de Islandia", "Repubblica d'Islanda", "Republiek IJsland", "República da Islândia", "Republika Islandii", "Islandská republika", "Republiken Island", "Republikken Island", "Islannin tasavalta", "Республика Исландия", "İzlanda Cumhuriyeti", "Δημοκρατία της Ισλανδίας", "アイスランド共和国", "冰岛共和国", "아이슬란드 공화국", "جمهوريّة آيسلندا", "Ísland", "Lýðveldið Ísland"},
+	"IT": {"Italian Republic", "Italienische Republik", "République italienne", "República Italiana", "Repubblica Italiana", "Italiaanse Republiek", "Republika Włoska", "Italská republika", "Italienska republiken", "Den Italienske Republik", "Republikken Italia", "Italian tasavalta", "Итальянская Республика", "İtalya Cumhuriyeti", "Ιταλική Δημοκρατία", "イタリア共和国", "意大利共和国", "이탈리아 공화국", "الجمهوريّة الإيطاليّة"},
+	"JO": {"Hashemite Kingdom of Jordan", "Haschemitisches Königreich Jordanien", "Royaume hachémite de Jordanie", "Reino Hachemí de Jordania", "Regno Hascimita di Giordania", "Hasjemitisch Koninkrijk Jordanië", "Reino Hachemita da Jordânia", "Haszymidzkie Królestwo Jordanii", "Jordánské hášimovské království", "Hashemitiska konungariket Jordanien", "Det Hashemitiske Kongerige Jordan", "Det hasjimittiske kongerike Jordan", "Jordanian hašemiittinen kuningaskunta", "Иорданское Хашимитское Королевство", "Ürdün Haşimi Krallığı", "Χασεμιτικό Βασίλειο της Ιορδανίας", "ヨルダン・ハシェミット王国", "约旦哈希姆王国", "요르단 하심 왕국", "المملكة الأردنيّة الهاشميّة"},
+	"KE": {"Republic of Kenya", "Republik Kenia", "République du Kenya", "República de Kenia", "Repubblica del Kenya", "Republiek Kenia", "República do Quénia", "Republika Kenii", "Keňská republika", "Republiken Kenya", "Republikken Kenya", "Kenian tasavalta", "Республика Кения", "Kenya Cumhuriyeti", "Δημοκρατία της Κένυας", "ケニア共和国", "肯尼亚共和国", "케냐 공화국", "جمهوريّة كينيا"},
+	"KG": {"Kyrgyz Republic", "Kirgisische Republik", "République kirghize", "República Kirguiza", "Repubblica del Kirghizistan", "Kirgizische Republiek", "República do Quirgistão", "Republika Kirgiska", "Kyrgyzská republika", "Kirgisiska republiken", "Den Kirgisiske Republik", "Republikken Kirgisistan", "Kirgisian tasavalta", "Республика Кыргызстан", "Kırgızistan Cumhuriyeti", "Δημοκρατία της Κιργιζίας", "キルギス共和国", "吉尔吉斯共和国", "키르기스 공화국", "الجمهوريّة القيرغزيّة"},
+	"KH": {"Kingdom of Cambodia", "Königreich Kambodscha", "Royaume du Cambodge", "Reino de Camboya", "Regno di Cambogia", "Koninkrijk Cambodja", "Reino do Camboja", "Królestwo Kambodży", "Kambodžské království", "Konungariket Kambodja", "Kongeriget Cambodia", "Kongeriket Kambodsja", "Kambodžan kuningaskunta", "Королевство Камбоджа", "Kamboçya Krallığı", "Βασίλειο της Καμπότζης", "カンボジア王国", "柬埔塞王国", "캄보디아 왕국", "مملكة كمبوديا"},
+	"KI": {"Republic of Kiribati", "Republik Kiribati", "République de Kiribati", "República de Kiribati", "Repubblica di Kiribati", "Republiek Kiribati", "Republika Kiribati", "Kiribatská republika", "Republiken Kiribati", "Republikken Kiribati", "Kiribatin tasavalta", "Республика Кирибати", "Kiribati Cumhuriyeti", "Δημοκρατία του Κιριμπάτι", "キリバス共和国", "基里巴斯共和国", "키리바시 공화국", "جمهوريّة كيريباتي"},
+	"KM": {"Union of the Comoros", "Vereinigung der Komoren", "Union des Comores", "Comores, Islas", "Unión de las Comores", "Unione delle Comore", "Unie van de Comoren", "União das Comores", "Związek Komorów", "Komorský svaz", "Unionen Comorerna", "Unionen Comorerne", "Den islamske forbundsrepublikk Komorene", "Komorien liitto", "Союз Коморских Островов", "Komorlar Birliği", "Ένωση των Κομορών", "コモロ連合", "科摩罗联邦", "코모로 연방", "إتّحاد جزر القمر"},
+	"KP": {"Korea, Democratic People's Republic of", "Democratic People's Republic of Korea", "Korea, Demokratische Volksrepublik", "Demokratische Volksrepublik Korea", "Corée, République populaire démocratique de", "République démocratique populaire de Corée", "Corea, República Democrática Popular de", "República Popular Democrática de Corea", "Repubblica democratica popolare di Corea", "Korea, Democratische Volksrepubliek", "Democratische Volksrepubliek Korea", "Coreia, República Popular Democrática da", "República Popular Democrática da Coreia", "Korea - Republika Ludowo-Demokratyczna", "Koreańska Republika Ludowo-Demokratyczna", "Korea, lidově demokratická republika", "Korejská lidově demokratická republika", "Korea, demokratiska folkrepubliken", "Demokratiska folkrepubliken Korea", "Korea, Den Demokratiske Folkerepublik", "Den Demokratiske Folkerepublik Korea", "Korea, Den demokratiske folkerepublikk", "Den demokratiske folkerepublikk Korea", "Korean demokraattinen kansantasavalta", "Корейская Народно-Демократическая Республика", "Kore Demokratik Halk Cumhuriyeti", "Κορέα, Λαοκρατική Δημοκρατία της", "Λαοκρατική Δημοκρατία της Κορέας", "朝鮮民主主義人民共和国", "朝鲜民主主义人民共和国", "كوريا، جمهورية كوريا الشّعبيّة الدّيموقراطيّة", "جمهوريّة كوريا الشّعبيّة الدّيموقراطيّة"},
+	"KR": {"Korea, Republic of", "Korea, Republik", "Corée, République de", "Corea, República de", "Corea del sud", "Korea, Republiek", "Coreia, República da", "Republika Korei", "Korea, republika", "Korea, Republikken", "Korean tasavalta", "Республика Корея", "Kore Cumhuriyeti", "Κορέα, Δημοκρατία της", "大韓民国 (韓国)", "大韩民国", "كوريا، جمهوريّة كوريا"},
+	"KW": {"State of Kuwait", "Staat Kuwait", "État du Koweït", "Estado de Kuwait", "Stato del Kuwait", "Staat Koeweit", "Estado do Kuwait", "Państwo Kuwejt", "Stát Kuvajt", "Staten Kuwait", "Kuwaitin valtio", "Государство Кувейт", "Kuveyt Devleti", "Κράτος του Κουβέιτ", "クウェート国", "科威特国", "دولة الكويت"},
+	"KZ": {"Republic of Kazakhstan", "Republik Kasachstan", "République du Kazakhstan", "República de Kazajistán", "Repubblica del Kazakistan", "Republiek Kazachstan", "República do Cazaquistão", "Republika Kazachstanu", "Kazachstánská republika", "Republiken Kazakstan", "Republikken Kasakhstan", "Kazakstanin tasavalta", "Республика Казахстан", "Kazakistan Cumhuriyeti", "Δημοκρατία του Καζακστάν", "カザフスタン共和国", "哈萨克斯坦共和国", "카자흐스탄 공화국", "جمهوريّة كازاخستان"},
+	"LA": {"Lao People's Democratic Republic", "Laos, Demokratische Volksrepublik", "Lao, République démocratique populaire", "República Democrática Popular de Lao", "Laos Democratische Volksrepubliek", "República Democrática Popular do Laos", "Laotańska Republika Ludowo-Demokratyczna", "Laoská lidově demokratická republika", "Demokratiska folkrepubliken Lao", "Lao, Folkets Demokratiske Republik", "Den demokratiske folkerepublikk Laos", "Лаосская Народно-Демократическая Республика", "Lao Demokratik Halk Cumhuriyeti", "Λαϊκή Δημοκρατία του Λάος", "ラオス人民民主共和国", "老挝人民民主共和国", "라오 인민 민주주의 공화국", "جمهوريّة لاو الدّيموقراطيّة الشّعبيّة"},
+	"LB": {"Lebanese Republic", "Libanesische Republik", "République libanaise", "República Libanesa", "Repubblica libanese", "Republiek Libanon", "República do Líbano", "Republika Libańska", "Libanonská republika", "Libanesiska republiken", "Den Libanesiske Republik", "Republikken Libanon", "Libanonin tasavalta", "Ливанская Республика", "Lübnan Cumhuriyeti", "Δημοκρατία του Λιβάνου", "レバノン共和国", "黎巴嫩共和国", "레바논 공화국", "الجمهوريّة اللّبنانيّة"},
+	"LI": {"Principality of Liechtenstein", "Fürstentum Liechtenstein", "Principauté du Liechtenstein", "Principado de Liechtenstein", "Principato del Liechtenstein", "Vorstendom Liechtenstein", "Principado do Liechtenstein", "Księstwo Liechtenstein", "Lichtenštejnské knížectví", "Furstendömet Liechtenstein", "Fyrstendømmet Liechtenstein", "Fyrstedømmet Liechtenstein", "Liechtensteinin ruhtinaskunta", "Княжество Лихтенштейн", "Lihtenştayn Prensliği", "Πριγκιπάτο του Λιχτενστάιν", "リヒテンシュタイン公国", "列支敦士登公国", "리히텐슈타인 공국", "إمارة ليشتنشتاين"},
+	"LK": {"Democratic Socialist Republic of Sri Lanka", "Demokratische sozialistische Republik Sri Lanka", "République démocratique socialiste de Sri Lanka", "República Socialista Democrática de Sri Lanka", "Repubblica Democratica Socialista dello Sri Lanka", "Democratische Socialistische Republiek Sri Lanka", "República Democrática Socialista do Sri Lanka", "Demokratyczno-Socjalistyczna Republika Sri Lanki", "Šrílanská demokratická socialistická republika", "Demokratiska socialistrepubliken Sri Lanka", "Den Demokratiske Socialistiske Republik Sri Lanka", "Den demokratiske sosialistiske republikk Sri Lanka", "Sri Lankan demokraattinen sosialistinen tasavalta", "Демократическая Социалистическая Республика Шри-Ланка", "Sri Lanka Demokratik Sosyalist Cumhuriyeti", "Λαϊκή Σοσιαλιστική Δημοκρατία της Σρι Λάνκα", "スリランカ民主社会主義共和国", "斯里兰卡民主社会主义共和国", "스리랑카 민주 사회주의 공화국", "جمهوريّة سريلانكا الاشتراكيّة الدّيموقراطيّة"},
+	"LR": {"Republic of Liberia", "Republik Liberia", "République du Libéria", "República de Liberia", "Repubblica di Liberia", "Republiek Liberia", "República da Libéria", "Republika Liberii", "Liberijská republika", "Republiken Liberia", "Republikken Liberia", "Liberian tasavalta", "Республика Либерия", "Liberya Cumhuriyeti", "Δημοκρατία της Λιβερίας", "リベリア共和国", "利比里亚共和国", "라이베리아 공화국", "جمهوريّة ليبيريا"},
+	"LS": {"Kingdom of Lesotho", "Königreich Lesotho", "Royaume du Lesotho", "Reino de Lesoto", "Regno del Lesotho", "Koninkrijk Lesotho", "Reino do Lesoto", "Królestwo Lesoto", "Lesothské království", "Konungariket Lesotho", "Kongeriget Lesotho", "Kongeriket Lesotho", "Lesothon kuningaskunta", "Королевство Лесото", "Lesoto Krallığı", "Βασίλειο του Λεσόθο", "レソト王国", "莱索托王国", "레소토 왕국", "مملكة ليسوتو"},
+	"LT": {"Republic of Lithuania", "Republik Litauen", "République de Lituanie", "República de Lituania", "Repubblica di Lituania", "Republiek Litouwen", "República da Lituânia", "Republika Litewska", "Litevská republika", "Republiken Litauen", "Republikken Litauen", "Liettuan tasavalta", "Литовская Республика", "Litvanya Cumhuriyeti", "Δημοκρατία της Λιθουανίας", "リトアニア共和国", "立陶宛共和国", "리투아니아 공화국", "جمهوريّة لثوانيا", "Lietuva", "Lietuvos Respublika"},
+	"LU": {"Grand Duchy of Luxembourg", "Großherzogtum Luxemburg", "Grand-duché du Luxembourg", "Gran Ducado de Luxemburgo", "Granducato di Lussemburgo", "Groothertogdom Luxemburg", "Grã-Ducado do Luxemburgo", "Wielkie Księstwo Luksemburg", "Lucemburské velkovévodství", "Storhertigdömet Luxemburg", "Storhertugdømmet Luxembourg", "Luxemburgin suurherttuakunta", "Великое Герцогство Люксембург", "Lüksemburg Büyük Dükalığı", "Μεγάλο Δουκάτο του Λουξεμβούργου", "ルクセンブルク大公国", "卢森堡大公国", "룩셈부르크 대공국", "دوقيّة لوكسمبورغ الكبرى"},
+	"LV": {"Republic of Latvia", "Republik Lettland", "République de Lettonie", "República de Letonia", "Repubblica di Lettonia", "Republiek Letland", "República da Letónia", "Republika Łotewska", "Lotyšská republika", "Republiken Lettland", "Republikken Letland", "Republikken Latvia", "Latvian tasavalta", "Латвийская Республика", "Letonya Cumhuriyeti", "Δημοκρατία της Λετονίας", "ラトビア共和国", "拉脱维亚共和国", "라트비아 공화국", "جمهوريّة لاتفيا", "Latvija", "Latvijas Republika"},
+	"MA": {"Kingdom of Morocco", "Königreich Marokko", "Royaume du Maroc", "Reino de Marruecos", "Regno del Marocco", "Koninkrijk Marokko", "Reino de Marrocos", "Królestwo Maroka", "Marocké království", "Konungariket Marocko", "Kongeriget Marokko", "Kongeriket Marokko", "Marokon kuningaskunta", "Королевство Марокко", "Fas Krallığı", "Βασίλειο του Μαρόκου", "モロッコ王国", "摩洛哥王国", "모로코 왕국", "المملكة المغربيّة"},
+	"MC": {"Principality of Monaco", "Fürstentum Monaco", "Principauté de Monaco", "Principado de Mónaco", "Principato di Monaco", "Vorstendom Monaco", "Principado do Mónaco", "Księstwo Monako", "Monacké knížectví", "Furstendömet Monaco", "Fyrstendømmet Monaco", "Fyrstedømmet Monaco", "Monacon ruhtinaskunta", "Княжество Монако", "Monako Prensliği", "Πριγκιπάτο του Μονακό", "モナコ公国", "摩纳哥公国", "모나코 공국", "إمارة موناكو"},
+	"MD": {"Moldova, Republic of", "Republic of Moldova", "Moldau, Republik", "Republik Moldau", "Moldova, République de", "République de Moldova", "Moldavia, República de", "República de Moldavia", "Repubblica di Moldavia", "Moldavië, Republiek", "Republiek Moldavië", "Moldávia, República da", "República da Moldávia", "Mołdawia - Republika", "Republika Mołdawii", "Moldavská republika", "Moldavien, republiken", "Republiken Moldavien", "Moldova, Republikken", "Republikken Moldova", "Moldovan tasavalta", "Республика Молдова", "Moldova Cumhuriyeti", "Μολδαβίας, Δημοκρατία της", "Δημοκρατία της Μολδαβίας", "モルドバ共和国", "摩尔多瓦共和国", "몰도바 공화국", "جمهورية مولدوفا", "جمهوريّة مولدوفا", "Republica Moldova"},
+	"ME": {"Црна Гора"},
+	"MF": {"Saint Martin (French part)"},
+	"MG": {"Republic of Madagascar", "Republik Madagaskar", "République de Madagascar", "República de Madagascar", "Repubblica del Madagascar", "Republiek Madagaskar", "República de Madagáscar", "Republika Madagaskaru", "Madagaskarská republika", "Republiken Madagaskar", "Republikken Madagaskar", "Madagaskarin tasavalta", "Республика Мадагаскар", "Madagaskar Cumhuriyeti", "Δημοκρατία της Μαδαγασκάρης", "マダガスカル共和国", "马达加斯加共和国", "마다가스카르 공화국", "جمهوريّة مدغشقر"},
+	"MH": {"Republic of the Marshall Islands", "Republik Marshallinseln", "République des Îles Marshall", "República de las Islas Marshall", "Repubblica delle Isole Marshall", "Republiek der Marshalleilanden", "República das Ilhas Marshall", "Republika Wysp Marshalla", "Republika Marshallovy ostrovy", "Republiken Marshallöarna", "Republikken Marshalløerne", "Republikken Marshalløyene", "Marshallinsaarten tasavalta", "Респу́блика Маршалловы Острова", "Marşal Adaları Cumhuriyeti", "Δημοκρατία των Νήσων Μάρσαλ", "マーシャル諸島共和国", "马绍尔群岛共和国", "마셜 제도 공화국", "جمهوريّة جزر المارشال"},
+	"MK": {"Republic of North Macedonia", "Republik Nordmazedonien", "République de Macédoine du Nord", "República de Macedonia del Norte", "Repubblica di Macedonia del Nord", "Republiek Noord-Macedonië", "República da Macedónia do Norte", "Republika Macedonii Północnej", "Republika Severní Makedonie", "Republiken Nordmakedonien", "Republikken Nordmakedonien", "Republikken Nord-Makedonia", "Республика Северная Македония", "Kuzey Makedonya Cumhuriyeti", "Δημοκρατία της Βόρειας Μακεδονίας", "北马其顿共和国", "북마케도니아 공화국", "جمهوريّة مقدونيا الشمالية"},
+	"ML": {"Republic of Mali", "Republik Mali", "République du Mali", "República de Mali", "Repubblica del Mali", "Republiek Mali", "República do Mali", "Republika Mali", "Maliská republika", "Republiken Mali", "Republikken Mali", "Malin tasavalta", "Республика Мали", "Mali Cumhuriyeti", "Δημοκρατία του Μάλι", "マリ共和国", "马里共和国", "말리 공화국", "جمهوريّة مالي"},
+	"MM": {"Republic of Myanmar", "Republik Myanmar", "République de Myanmar", "República de la Unión de Myanmar", "Repubblica cooperativistica di Myanmar", "Republiek Myanmar", "República da Birmânia", "Republika Związku Mjanmy", "Republika Myanmarský svaz", "Republiken Myanmar", "Republikken Burma", "Republikken Myanmarunionen", "Myanmarin tasavalta", "Республика Мьянма", "Myanmar Cumhuriyeti", "Δημοκρατία της Μιανμάρ", "ミャンマー共和国", "缅甸联邦共和国", "미얀마 공화국", "جمهورية اتحاد ميانمار"},
+	"MO": {"Macao Special Administrative Region of China", "Sonderverwaltungsregion Macao", "Région spéciale administrative chinoise de Macao", "Región Administrativa Especial China de Macao", "Regione Amministrativa Speciale di Macao della Repubblica Popolare Cinese", "Speciale Administratieve Regio Macau van de Volksrepubliek China", "Macau, Região Especial de Administração Chinesa", "Makau - Specjalny Region Administracyjny Chińskiej Republiki Ludowej", "Macao, zvláštní administrativní oblast Číny", "Särskilda administrativa regionen Macao inom Kina", "Det Særlige Administrative Område Macao", "Macao spesielle administrative region av Kina", "Kiinan kansantasavallan erityishallintoalue Macao", "Специальный Административный район Макао", "Çin Halk Cumhuriyeti Makao Özel İdari Bölgesi", "Μακάο Ειδική Διοικητική Περιοχή της Κίνας", "マカオ・中国特別行政区", "中国澳门特别行政区", "마카오, 중국의 특별 행정구", "مكّاو المنطقة الصّينيّة الإداريّة الخاصّة"},
+	"MP": {"Commonwealth of the Northern Mariana Islands", "Commonwealth Nördliche Mariana-Inseln", "Commonwealth des îles Mariannes du Nord", "Commonwealth de las Islas Marianas del Norte", "Commonwealth delle Isole Marianne settentrionali", "Gemenebest van de Noordelijke Marianen", "Comunidade das Ilhas Marianas do Norte", "Wspólnota Marianów Północnych", "Společenství Severních Marian", "Samväldet nordmarianerna", "Samveldet Nord-Marianene", "Pohjois-Mariaanien liittovaltio", "Содружество Северных Марианских островов", "Kuzey Mariana Adaları Milletler Topluluğu", "Κοινοπολιτεία των Νήσων Βορείων Μαριάννων", "北マリアナ諸島連邦", "北马里亚纳群岛自由联邦", "북마리아나 제도 연방", "كومنولث جزر ماريانا الشّماليّة"},
+	"MR": {"Islamic Republic of Mauritania", "Islamische Republik Mauretanien", "République islamique de Mauritanie", "República Islámica de Mauritania", "Repubblica islamica di Mauritania", "Islamitische Republiek Mauritanië", "República Islâmica da Mauritânia", "Mauretańska Republika Islamska", "Mauritánská islámská republika", "Islamiska republiken Mauretanien", "Den Islamiske Republik Mauretanien", "Den islamske republikk Mauretania", "Mauritanian islamilainen tasavalta", "Исламская Республика Мавритания", "Moritanya İslâm Cumhuriyeti", "Ισλαμική Δημοκρατία της Μαυριτανίας", "モーリタニア・イスラム共和国", "毛里塔尼亚伊斯兰共和国", "모리타니 이슬람 공화국", "جمهوريّة موريتانيا الإسلاميّة"},
+	"MT": {"Republic of Malta", "Republik Malta", "République de Malte", "República de Malta", "Repubblica di Malta", "Republiek Malta", "Republika Malty", "Maltská republika", "Republiken Malta", "Republikken Malta", "Maltan tasavalta", "Республика Мальта", "Malta Cumhuriyeti", "Δημοκρατία της Μάλτας", "マルタ共和国", "马尔他共和国", "몰타 공화국", "جمهوريّة مالطة"},
+	"MU": {"Republic of Mauritius", "Republik Mauritius", "République de l'Île Maurice", "República de Mauricio", "Repubblica di Mauritius", "Republiek Mauritius", "República de Maurícias", "Republika Mauritiusa", "Mauricijská republika", "Republiken Mauritius", "Republikken Mauritius", "Mauritiuksen tasavalta", "Республика Маврикий", "Mauritius Cumhuriyeti", "Δημοκρατία του Μαυρικίου", "モーリシャス共和国", "毛里求斯共和国", "모리셔스 공화국", "جمهوريّة موريشيوس"},
+	"MV": {"Republic of Maldives", "Republik Malediven", "République des Maldives", "República de Maldivas", "Repubblica delle Maldive", "Republiek der Maldiven", "República das Maldivas", "Republika Malediwów", "Maledivská republika", "Republiken Maldiverna", "Republikken Maldiverne", "Republikken Maldivene", "Malediivien tasavalta", "Мальдивская Республика", "Maldivler Cumhuriyeti", "Δημοκρατία των Μαλδιβών", "モルディブ共和国", "马尔代夫共和国", "몰디브 공화국", "جمهوريّة جزر المالديف"},
+	"MW": {"Republic of Malawi", "Republik Malawi", "République du Malawi", "República de Malawi", "Repubblica del Malawi", "Republiek Malawi", "República do Malawi", "Republika Malawi", "Malawská republika", "Republiken Malawi", "Republikken Malawi", "Malawin tasavalta", "Республика Малави", "Malavi Cumhuriyeti", "Δημοκρατία του Μαλάουι", "マラウイ共和国", "马拉维共和国", "말라위 공화국", "جمهوريّة ملاوي"},
+	"MX": {"United Mexican States", "Vereinigte Mexikanische Staaten", "États-Unis du Mexique", "Estados Unidos Mexicanos", "Stati Uniti Messicani", "Verenigde Mexicaanse Staten", "Stany Zjednoczone Meksyku", "Spojené státy mexické", "Förenade mexikanska staterna", "De Forenede Mexicanske Stater", "De forente stater Mexico", "Meksikon yhdysvallat", "Мексиканские Соединённые Штаты", "Birleşik Meksika Devletleri", "Ηνωμένες Πολιτείες του Μεξικού", "メキシコ合衆国", "墨西哥合众国", "멕시코 합중국", "الولايات المكسيكيّة المتّحدة"},
+	"MZ": {"Republic of Mozambique", "Republik Mosambik", "République du Mozambique", "República de Mozambique", "Repubblica del Mozambico", "Republiek Mozambique", "República de Moçambique", "Republika Mozambiku", "Mosambická republika", "Republiken Moçambique", "Republikken Mozambique", "Republikken Mosambik", "Mosambikin tasavalta", "Республика Мозамбик", "Mozambik Cumhuriyeti", "Δημοκρατία της Μοζαμβίκης", "モザンビーク共和国", "莫桑比克共和国", "모잠비크 공화국", "جمهوريّة موزمبيق"},
+	"NA": {"Republic of Namibia", "Republik Namibia", "République de Namibie", "República de Namibia", "Repubblica di Namibia", "Republiek Namibië", "República da Namíbia", "Republika Namibii", "Namibijská republika", "Republiken Namibia", "Republikken Namibia", "Namibian tasavalta", "Республика Намибия", "Namibya Cumhuriyeti", "Δημοκρατία της Ναμίμπιας", "ナミビア共和国", "纳米比亚共和国", "나미비아 공화국", "جمهوريّة ناميبيا"},
+	"NE": {"Republic of the Niger", "Republik Niger", "République du Niger", "República del Níger", "Repubblica del Niger", "Republiek Niger", "República do Níger", "Republika Nigru", "Nigerská republika", "Republiken Niger", "Republikken Niger", "Nigerin tasavalta", "Республика Нигер", "Nijer Cumhuriyeti", "Δημοκρατία του Νίγηρα", "ニジェール共和国", "尼日尔共和国", "니제르 공화국", "جمهوريّة النّيجر"},
+	"NG": {"Federal Republic of Nigeria", "Bundesrepublik Nigeria", "République fédérale du Nigeria", "República Federal de Nigeria", "Repubblica federale della Nigeria", "Federale Republiek Nigeria", "República Federal da Nigéria", "Federacyjna Republika Nigerii", "Nigerijská federativní republika", "Förbundsrepubliken Nigeria", "Forbundsrepublikken Nigeria", "Nigerian liittotasavalta", "Федеративная Республика Нигерия", "Nijerya Federal Cumhuriyeti", "Ομοσπονδιακή Δημοκρατία της Νιγηρίας", "ナイジェリア連邦共和国", "尼日利亚联邦共和国", "나이지리아 연방 공화국", "جمهوريّة نيجيريا الاتّحاديّة"},
+	"NI": {"Republic of Nicaragua", "Republik Nicaragua", "République du Nicaragua", "República de Nicaragua", "Repubblica di Nicaragua", "Republiek Nicaragua", "República da Nicarágua", "Republika Nikaragui", "Nikaragujská republika", "Republiken Nicaragua", "Republikken Nicaragua", "Nicaraguan tasavalta", "Республика Никарагуа", "Nikaragua Cumhuriyeti", "Δημοκρατία της Νικαράγουας", "ニカラグア共和国", "尼加拉瓜共和国", "니카라과 공화국", "جمهوريّة نيكاراغوا"},
+	"NL": {"Kingdom of the Netherlands", "Königreich der Niederlande", "Royaume des Pays-Bas", "Reino de los Países Bajos", "Regno dei Paesi Bassi", "Koninkrijk der Nederlanden", "Reino dos Países Baixos", "Królestwo Holandii", "Nizozemské království", "Konungariket Nederländerna", "Kongeriget Nederlandene", "Kongeriket Nederland", "Alankomaiden kuningaskunta", "Королевство Нидерландов", "Hollanda Krallığı", "Βασίλειο των Κάτω Χωρών", "オランダ王国", "荷兰王国", "네덜란드 왕국", "مملكة هولندا"},
+	"NO": {"Kingdom of Norway", "Königreich Norwegen", "Royaume de Norvège", "Reino de Noruega", "Regno di Norvegia", "Koninkrijk Noorwegen", "Reino da Noruega", "Królestwo Norwegii", "Norské království", "Konungariket Norge", "Kongeriget Norge", "Kongeriket Norge", "Norjan kuningaskunta", "Королевство Норвегия", "Norveç Krallığı", "Βασίλειο της Νορβηγίας", "ノルウェー王国", "挪威王国", "노르웨이 왕국", "مملكة النّرويج"},
+	"NP": {"Federal Democratic Republic of Nepal", "Demokratische Bundesrepublik Nepal", "République fédérale démocratique du Népal", "República Federal Democrática de Nepal", "Repubblica federale democratica del Nepal", "Federale Democratische Republiek van Nepal", "República Democrática Federal do Nepal", "Federalna Demokratyczna Republika Nepalu", "Nepálská federativní demokratická republika", "Demokratiska förbundsrepubliken Nepal", "Den Føderale Demokratiske Republik Nepal", "Den føderale demokratiske republikk Nepal", "Nepalin demokraattinen liittotasavalta", "Федеративная Демократическая Республика Непал", "Nepal Federal Demokratik Cumhuriyeti", "Ομοσπονδιακή Λαϊκή Δημοκρατία του Νεπάλ", "ネパール連邦民主共和国", "尼泊尔联邦民主共和国", "네팔 연방 민주 공화국", "جمهورية النيبال الاتحادية الديموقراطيّة"},
+	"NR": {"Republic of Nauru", "Republik Nauru", "République de Nauru", "República de Nauru", "Repubblica di Nauru", "Republiek Nauru", "Republika Nauru", "Naurská republika", "Republiken Nauru", "Republikken auru", "Republikken Nauru", "Naurun tasavalta", "Республика Науру", "Nauru Cumhuriyeti", "Δημοκρατία του Ναουρού", "ナウル共和国", "瑙鲁共和国", "나우루 공화국", "جمهوريّة ناورو"},
+	"OM": {"Sultanate of Oman", "Sultanat Oman", "Sultanat d'Oman", "Sultanato de Omán", "Sultanato dell'Oman", "Sultanaat Oman", "Sultanato de Omã", "Sułtanat Omanu", "Ománský sultanát", "Sultanatet Oman", "Omanin sulttaanikunta", "Султанат Оман", "Umman Sultanlığı", "Σουλτανάτο του Ομάν", "オマーン国", "阿曼苏丹国", "오만 이슬람왕국", "سلطنة عمان"},
+	"PA": {"Republic of Panama", "Republik Panama", "République du Panama", "República de Panamá", "Repubblica di Panama", "Republiek Panama", "República do Panamá", "Republika Panamy", "Panamská republika", "Republiken Panama", "Republikken Panama", "Panaman tasavalta", "Республика Панама", "Panama Cumhuriyeti", "Δημοκρατία του Παναμά", "パナマ共和国", "巴拿马共和国", "파나마 공화국", "جمهوريّة بنما"},
+	"PE": {"Republic of Peru", "Republik Peru", "République du Pérou", "República del Perú", "Repubblica del Perù", "Republiek Peru", "República do Peru", "Republika Peru", "Peruánská republika", "Republiken Peru", "Republikken Peru", "Perun tasavalta", "Республика Перу", "Peru Cumhuriyeti", "Δημοκρατία του Περού", "ペルー共和国", "秘鲁共和国", "페루 공화국", "جمهوريّة البيرو"},
+	"PG": {"Independent State of Papua New Guinea", "Unabhängiger Staat Papua-Neuguinea", "État indépendant de Papouasie-Nouvelle-Guinée", "Estado Independiente de Papúa Nueva Guinea", "Stato indipendente di Papua Nuova Guinea", "Onafhankelijke Staat Papua Nieuw Guinea", "Estado Independente de Papua-Nova Guiné", "Niezależne Państwo Papui-Nowej Gwinei", "Nezávislý stát Papua-Nová Guinea", "Oberoende staten Papua Nya Guinea", "Den Uafhængige Stat Papua Ny Guinea", "Den uavhengige staten Papua Ny-Guinea", "Независимое Государство Папуа — Новая Гвинея", "Papua Yeni Gine Bağımsız Devleti", "Ανεξάρτητο Κράτος της Παπούα Νέα Γουινέα", "パプアニューギニア独立国", "巴布亚新几内亚独立国", "파푸아뉴기니 독립국", "دولة بابوا غينيا الجديدة المستقلة"},
+	"PH": {"Republic of the Philippines", "Republik der Philippinen", "République des Philippines", "República de Filipinas", "Repubblica delle Filippine", "Republiek der Filipijnen", "República das Filipinas", "Republika Filipin", "Filipínská republika", "Republiken Filippinerna", "Republikken Filippinerne", "Republikken Filippinene", "Filippiinien tasavalta", "Республика Филиппины", "Filipinler Cumhuriyeti", "Δημοκρατία των Φιλιππινών", "フィリピン共和国", "菲律宾共和国", "필리핀 공화국", "جمهوريّة الفيلبّين"},
+	"PK": {"Islamic Republic of Pakistan", "Islamische Republik Pakistan", "République islamique du Pakistan", "República Islámica de Pakistán", "Repubblica islamica del Pakistan", "Islamitische Republiek Pakistan", "República Islâmica do Paquistão", "Islamska Republika Pakistanu", "Pákistánská islámská republika", "Islamiska republiken Pakistan", "Den Islamiske Republik Pakistan", "Den islamske republikk Pakistan", "Pakistanin islamilainen tasavalta", "Исламская Республика Пакистан", "Pakistan İslam Cumhuriyeti", "Ισλαμική Δημοκρατία του Πακιστάν", "パキスタン・イスラム共和国", "巴基斯坦伊斯兰共和国", "파키스탄 이슬람 공화국", "جمهوريّة باكستان الإسلاميّة"},
+	"PL": {"Republic of Poland", "Republik Polen", "République de Pologne", "República de Polonia", "Repubblica di Polonia", "Republiek Polen", "República da Polónia", "Rzeczpospolita Polska", "Polská republika", "Republiken Polen", "Republikken Polen", "Puolan tasavalta", "Республика Польша", "Polonya Cumhuriyeti", "Δημοκρατία της Πολωνίας", "ポーランド共和国", "波兰共和国", "폴란드 공화국", "جمهوريّة بولندا"},
+	"PS": {"Palestine, State of", "the State of Palestine", "Palästina, Staat", "Staat Palästina", "Palestine, État de", "l'État de Palestine", "Palestina, Estado de", "Estado de Palestina", "Palestina, Stato di", "Stato di Palestina", "Palestina, Staat", "Staat Palestina", "Palestina, Estado da", "Estado da Palestina", "Państwo Palestyna", "Stát Palestina", "Palæstina, staten", "Staten Palæstina", "Palestina, staten", "Staten Palestina", "Государство Палестина", "Κράτος της Παλαιστίνης", "パレスチナ自治区", "巴勒斯坦国"},
+	"PT": {"Portuguese Republic", "Portugiesische Republik", "République portugaise", "República Portuguesa", "Repubblica del Portogallo", "Portugese Republiek", "Republika Portugalska", "Portugalská republika", "Portugisiska republiken", "Den Portugisiske Republik", "Republikken Portugal", "Portugalin tasavalta", "Португальская Республика", "Portekiz Cumhuriyeti", "Πορτογαλική Δημοκρατία", "ポルトガル共和国", "葡萄牙共和国", "포르투갈 공화국", "الجمهوريّة البرتغاليّة"},
+	"PW": {"Republic of Palau", "Republik Palau", "République de Palau", "República de Palau", "Repubblica di Palau", "Republiek Palau", "Republika Palau", "Palauská republika", "Republiken Palau", "Republikken Palau", "Palaun tasavalta", "Республика Палау", "Palau Cumhuriyeti", "Δημοκρατία του Παλάου", "パラオ共和国", "帕劳共和国", "팔라우 공화국", "جمهوريّة بالاو"},
+	"PY": {"Republic of Paraguay", "Republik Paraguay", "République du Paraguay", "República del Paraguay", "Repubblica del Paraguay", "Republiek Paraguay", "República do Paraguai", "Republika Paragwaju", "Paraguayská republika", "Republiken Paraguay", "Republikken Paraguay", "Paraguayn tasavalta", "Республика Парагвай", "Paraguay Cumhuriyeti", "Δημοκρατία της Παραγουάης", "パラグアイ共和国", "巴拉圭共和国", "파라과이 공화국", "جمهوريّة الباراغواي"},
+	"QA": {"State of Qatar", "Staat Katar", "État du Qatar", "Estado de Qatar", "Stato del Qatar", "Staat Qatar", "Estado do Catar", "Państwo Kataru", "Stát Katar", "Staten Qatar", "Qatarin valtio", "Государство Катар", "Katar Devleti", "Κράτος του Κατάρ", "カタール国", "卡塔尔国", "دولة قطر"},
+	"RE": {"Réunion, Île de la"},
+	"RO": {"România"},
+	"RS": {"Republic of Serbia", "Republik Serbien", "République de Serbie", "República de Serbia", "Repubblica di Serbia", "Republiek Servië", "República da Sérvia", "Republika Serbii", "Srbská republika", "Republiken Serbien", "Republikken Serbien", "Republikken Serbia", "Serbian tasavalta", "Республика Сербия", "Sırbistan Cumhuriyeti", "Δημοκρατία της Σερβίας", "セルビア共和国", "塞尔维亚共和国", "세르비아 공화국", "جمهوريّة صربية", "Србија", "Република Србија"},
+	"RU": {"Russian Federation", "Russie, Fédération de"},
+	"RW": {"Rwandese Republic", "Republik Ruanda", "République rwandaise", "República de Ruanda", "Repubblica del Ruanda", "Republiek Rwanda", "República do Ruanda", "Republika Ruandyjska", "Rwandská republika", "Rwandiska republiken", "Den Rwandiske Republik", "Republikken Rwanda", "Ruandan tasavalta", "Руандийская Республика", "Ruanda Cumhuriyeti", "Δημοκρατία της Ρουάντα", "ルワンダ共和国", "卢旺达共和国", "르완다 공화국", "الجمهوريّة الرّوانديّة"},
+	"SA": {"Kingdom of Saudi Arabia", "Königreich Saudi-Arabien", "Royaume d'Arabie saoudite", "Reino de Arabia Saudí", "Regno dell'Arabia Saudita", "Koninkrijk Saudi-Arabië", "Reino da Arábia Saudita", "Królestwo Arabii Saudyjskiej", "Saúdskoarabské království", "Konungariket Saudiarabien", "Kongeriget Saudi-Arabien", "Kongeriket Saudi-Arabia", "Saudi-Arabian kuningaskunta", "Королевство Саудовская Аравия", "Suudi Arabistan Krallığı", "Βασίλειο της Σαουδικής Αραβίας", "サウジアラビア王国", "沙特阿拉伯王国", "사우디아라비아 왕국", "المملكة العربيّة السّعوديّة"},
+	"SB": {"Salomon, Îles"},
+	"SC": {"Republic of Seychelles", "Republik Seychellen", "République des Seychelles", "República de las Seychelles", "Repubblica delle Seychelles", "Republiek Seychellen", "República das Seychelles", "Republika Seszeli", "Seychelská republika", "Republiken Seychellerna", "Republikken Seychellerne", "Republikken Seychellene", "Seychellien tasavalta", "Республика Сейшельские Острова", "Seyşeller Cumhuriyeti", "Δημοκρατία των Σεϋχελλών", "セーシェル共和国", "塞舌尔共和国", "세이셸 공화국", "جمهوريّة السّيشل"},
+	"SD": {"Republic of the Sudan", "Republik Sudan", "République du Soudan", "República de Sudán", "Repubblica del Sudan", "Republiek Soedan", "República do Sudão", "Republika Sudanu", "Súdánská republika", "Republiken Sudan", "Republikken Sudan", "Sudanin tasavalta", "Республика Судан", "Sudan Cumhuriyeti", "Δημοκρατία του Σουδάν", "スーダン共和国", "苏丹共和国", "수단 공화국", "جمهوريّة السّودان"},
+	"SE": {"Kingdom of Sweden", "Königreich Schweden", "Royaume de Suède", "Reino de Suecia", "Regno di Svezia", "Koninkrijk Zweden", "Reino da Suécia", "Królestwo Szwecji", "Švédské království", "Konungariket Sverige", "Kongeriget Sverige", "Kongeriket Sverige", "Ruotsin kuningaskunta", "Королевство Швеция", "İsveç Krallığı", "Βασίλειο της Σουηδίας", "スウェーデン王国", "瑞典王国", "스웨덴 왕국", "مملكة السّويد"},
+	"SG": {"Republic of Singapore", "Republik Singapur", "République de Singapour", "República de Singapur", "Repubblica di Singapore", "Republiek Singapore", "República de Singapura", "Republika Singapuru", "Singapurská republika", "Republiken Singapore", "Republikken Singapore", "Singaporen tasavalta", "Республика Сингапур", "Singapur Cumhuriyeti", "Δημοκρατία της Σιγκαπούρης", "シンガポール共和国", "新加坡共和国", "싱가포르 공화국", "جمهوريّة سنغافورة", "Singapura"},
+	"SH": {"Saint Helena, Ascension and Tristan da Cunha", "St. Helena, Ascension und Tristan da Cunha", "Sainte-Hélène, Ascension et Tristan da Cunha", "Santa Elena, Ascensión y Tristán de Acuña", "Sant'Elena, Ascensione e Tristan da Cunha", "Sint-Helena, Ascension en Tristan da Cunha", "Santa Helena, Ascensão e Tristão da Cunha", "Wyspa Świętej Heleny, Wyspa Wniebowstąpienia i Tristan da Cunha", "Svatá Helena, Ascension a Tristan da Cunha", "Saint Helena, Ascension och Tristan da Cunha", "Sankt Helena, Ascension og Tristan da Cunha", "Saint Helena, Ascension og Tristan da Cunha", "Saint Helena, Ascension ja Tristan da Cunha", "Остров Святой Елены, Остров Вознесения и Тристан-да-Кунья", "Saint Helena, Ascension ve Tristan da Cunha", "Σεντ Ελένα, Ασενσιόν και Τριστάν ντα Κούνχα"},
+	"SI": {"Republic of Slovenia", "Republik Slowenien", "République de Slovénie", "República de Eslovenia", "Repubblica di Slovenia", "Republiek Slovenië", "República da Eslovénia", "Republika Słowenii", "Slovinská republika", "Republiken Slovenien", "Republikken Slovenien", "Republikken Slovenia", "Slovenian tasavalta", "Республика Словения", "Slovenya Cumhuriyeti", "Δημοκρατία της Σλοβενίας", "スロベニア共和国", "斯洛文尼亚共和国", "슬로베니아 공화국", "جمهوريّة سلوفينيا", "Slovenija", "Republika Slovenija"},
+	"SK": {"Slovak Republic", "Slowakische Republik", "République slovaque", "República Eslovaca", "Repubblica slovacca", "Slovaakse Republiek", "Republika Słowacka", "Slovenská republika", "Slovakiska republiken", "Den Slovakiske Republik", "Den slovakiske republikk", "Slovakian tasavalta", "Словацкая Республика", "Slovakya Cumhuriyeti", "Δημοκρατία της Σλοβακίας", "スロバキア共和国", "斯洛伐克共和国", "슬로바키아 공화국", "جمهورية سلوفاكيا", "Slovensko"},
+	"SL": {"Republic of Sierra Leone", "Republik Sierra Leone", "République de Sierra Leone", "República de Sierra Leona", "Repubblica della Sierra Leone", "Republiek Sierra Leone", "República da Serra Leoa", "Republika Sierra Leone", "Republiken Sierra Leone", "Republikken Sierra Leone", "Sierra Leonen tasavalta", "Республика Сьерра-Леоне", "Sierra Leone Cumhuriyeti", "Δημοκρατία της Σιέρρα Λεόνε", "シエラレオネ共和国", "塞拉利昂共和国", "시에라리온 공화국", "جمهوريّة سيراليون"},
+	"SM": {"Republic of San Marino", "Republik San Marino", "République de San Marin", "República de San Marino", "Repubblica di San Marino", "Republiek San Marino", "Republika San Marino", "Sanmarinská republika", "Republiken San Marino", "Republikken San Marino", "San Marinon tasavalta", "Республика Сан-Марино", "San Marino Cumhuriyeti", "Δημοκρατία του Αγίου Μαρίνου", "サンマリノ共和国", "圣马力诺共和国", "산마리노 공화국", "جمهوريّة سان مارينو"},
+	"SN": {"Republic of Senegal", "Republik Senegal", "République du Sénégal", "República del Senegal", "Repubblica del Senegal", "Republiek Senegal", "República do Senegal", "Republika Senegalu", "Senegalská republika", "Republiken Senegal", "Republikken Senegal", "Senegalin tasavalta", "Республика Сенегал", "Senegal Cumhuriyeti", "Δημοκρατία της Σενεγάλης", "セネガル共和国", "塞内加尔共和国", "세네갈 공화국", "جمهوريّة السّنغال"},
+	"SO": {"Federal Republic of Somalia", "Bundesrepublik Somalia", "République fédérale de Somalie", "República Federal de Somalia", "Repubblica federale di Somalia", "Federale Republiek Somalië", "República Federal da Somália", "Federalna Republika Somalii", "Somálská federativní republika", "Förbundsrepubliken Somalia", "Forbundsrepublikken Somalia", "Федеративная Республика Сомали", "Somali Federal Cumhuriyeti", "Ομοσπονδιακή Δημοκρατία της Σομαλίας", "ソマリア連邦共和国", "索马里联邦共和国", "소말리아 연방 공화국", "جمهورية الصومال الفيدرالية"},
+	"SR": {"Republic of Suriname", "Republik Suriname", "République du Surinam", "República de Surinam", "Repubblica di Suriname", "Republiek Suriname", "República do Suriname", "Republika Surinamu", "Surinamská republika", "Republiken Surinam", "Republikken Surinam", "Surinamen tasavalta", "Республика Суринам", "Surinam Cumhuriyeti", "Δημοκρατία του Σουρινάμ", "スリナム共和国", "苏里南共和国", "수리남 공화국", "جمهوريّة سورينام"},
+	"SS": {"Republic of South Sudan", "Republik Südsudan", "République du Soudan du Sud", "República de Sudán del Sur", "Repubblica del Sudan del Sud", "Republiek Zuid-Soedan", "República do Sudão do Sul", "Republika Sudanu Południowego", "Jihosúdánská republika", "Republiken Sydsudan", "Republikken Sydsudan", "Republikken Sør-Sudan", "Etelä-Sudanin tasavalta", "Республика Южный Судан", "Güney Sudan Cumhuriyeti", "Δημοκρατία του Νότιου Σουδάν", "南スーダン共和国", "南苏丹共和国", "남수단 공화국", "جمهورية جنوب السّودان"},
+	"ST": {"Democratic Republic of Sao Tome and Principe", "Demokratische Republik São Tomé und Príncipe", "République démocratique de Sao Tomé et Principe", "República Democrática de Santo Tomé y Príncipe", "Repubblica democratica di São Tomé e Príncipe", "Democratische Republiek Sao Tomé en Principe", "República Democrática de São Tomé e Príncipe", "Demokratyczna Republika Wysp Św. Tomasza i Książęcej", "Demokratická republika Svatý Tomáš a Princův ostrov", "Demokratiska republiken São Tomé och Príncipe", "Den Demokratiske Republik São Tomé og Príncipe", "Den demokratiske republikk São Tomé og Príncipe", "São Tomén ja Príncipen demokraattinen tasavalta", "Демократическая Республика Сан-Томе и Принсипи", "Sao Tome ve Principe Demokratik Cumhuriyeti", "Λαϊκή Δημοκρατία του Σάο Τομέ και Πρίνσιπε", "サントメ・プリンシペ民主共和国", "圣多美和普林西比民主共和国", "상투메 프린시페 민주 공화국", "جمهوريّة ساو تومي و برنسبي الدّيموقراطيّة"},
+	"SV": {"Republic of El Salvador", "Republik El Salvador", "République d'El Salvador", "República de El Salvador", "Repubblica di El Salvador", "Republiek El Salvador", "Republika Salwadoru", "Salvadorská republika", "Republiken El Salvador", "Republikken El Salvador", "El Salvadorin tasavalta", "Республика Эль-Сальвадор", "El Salvador Cumhuriyeti", "Δημοκρατία του Ελ Σαλβαδόρ", "エルサルバドル共和国", "萨尔瓦多共和国", "엘살바도르 공화국", "جمهوريّة السّلفادور"},
+	"SX": {"Sint Maarten (Dutch part)"},
+	"SY": {"Syrian Arab Republic", "Syrien, Arabische Republik", "Syrienne, République arabe", "República árabe de Siria", "Siria", "República Árabe Síria", "Syryjska Republika Arabska", "Syrská arabská republika", "Syriska arabrepubliken", "Syriske Arabiske Republik", "Den arabiske republikk Syria", "Syyrian arabitasavalta", "Сирийская Арабская Республика", "Suriye Arap Cumhuriyeti", "Αραβική Δημοκρατία της Συρίας", "シリア・アラブ共和国", "阿拉伯叙利亚共和国", "시리아 아랍 공화국", "الجمهوريّة العربيّة السّوريّة"},
+	"SZ": {"Kingdom of Eswatini", "Königreich Eswatini", "Royaume d’Eswatini", "Reino de Esuatini", "Regno di Eswatini", "Koninkrijk Eswatini", "Reino da Suazilândia", "Królestwo Eswatini", "Svazijské království", "Konungariket Eswatini", "Kongeriget Eswatini", "Kongeriket Eswatini", "Королевство Эсватини", "Eswatini Krallığı", "Βασίλειο του Εσουατίνι", "斯威士兰王国", "에스와티니 왕국", "مملكة إسواتيني"},
+	"TD": {"Republic of Chad", "Republik Tschad", "République du Tchad", "República del Chad", "Repubblica del Ciad", "Republiek Tsjaad", "República do Chade", "Republika Czadu", "Čadská republika", "Republiken Tchad", "Republikken Tchad", "Republikken Tsjad", "Tšadin tasavalta", "Республика Чад", "Çad Cumhuriyeti", "Δημοκρατία του Τσαντ", "チャド共和国", "乍得共和国", "차드 공화국", "جمهوريّة تشاد"},
+	"TG": {"Togolese Republic", "Republik Togo", "République togolaise", "República Togolesa", "Repubblica del Togo", "Republiek Togo", "Republika Togijska", "Tožská republika", "Togolesiska republiken", "Den Togolesiske Republik", "Republikken Togo", "Togon tasavalta", "Тоголезская Республика", "Togo Cumhuriyeti", "Δημοκρατία του Τόγκο", "トーゴ共和国", "多哥共和国", "토고 공화국", "الجمهوريّة التّوغويّة"},
+	"TH": {"Kingdom of Thailand", "Königreich Thailand", "Royaume de Thaïlande", "Reino de Tailandia", "Regno di Thailandia", "Koninkrijk Thailand", "Reino da Tailândia", "Królestwo Tajlandii", "Thajské království", "Konungariket Thailand", "Kongeriget Thailand", "Kongeriket Thailand", "Thaimaan kuningaskunta", "Королевство Таиланд", "Tayland Krallığı", "Βασίλειο της Ταϊλάνδης", "タイ王国", "泰王国", "타일랜드 왕국", "مملكة تايلاند", "ไทย", "ราชอาณาจักรไทย"},
+	"TJ": {"Republic of Tajikistan", "Republik Tadschikistan", "République du Tadjikistan", "República de Tayikistán", "Repubblica del Tagikistan", "Republiek Tadzjikistan", "República do Tajiquistão", "Republika Tadżykistanu", "Tádžická republika", "Republiken Tadzjikistan", "Republikken Tadsjikistan", "Tadžikistanin tasavalta", "Республика Таджикистан", "Tacikistan Cumhuriyeti", "Δημοκρατία του Τατζικιστάν", "タジキスタン共和国", "塔吉克斯坦共和国", "타지키스탄 공화국", "جمهوريّة طاجيكستان"},
+	"TL": {"Democratic Republic of Timor-Leste", "Demokratische Republik Timor-Leste", "République démocratique du Timor-Leste", "República Democrática de Timor Oriental", "Repubblica Democratica di Timor Est", "Democratische Republiek Oost-Timor", "República Democrática de Timor-Leste", "Demokratyczna Republika Timoru Wschodniego", "Demokratická republika Východní Timor", "Demokratiska republiken Östtimor", "Den Demokratiske Republik Timor-Leste", "Folkerepublikken Øst-Timor", "Itä-Timorin demokraattinen tasavalta", "Демократическая Республика Восточный Тимор", "Timor-Leste Demokratik Cumhuriyeti", "Λαϊκη Δημοκρατία του Ανατολικού Τιμόρ", "東ティモール民主共和国", "东帝汶民主共和国", "동티모르 민주 공화국", "جمهوريّة تيمور-ليستي الدّيموقراطيّة"},
+	"TN": {"Republic of Tunisia", "Tunesische Republik", "République de Tunisie", "República de Túnez", "Repubblica tunisina", "Republiek Tunesië", "República da Tunísia", "Republika Tunezyjska", "Tuniská republika", "Republiken Tunisien", "Den Tunesiske Republik", "Republikken Tunisia", "Tunisian tasavalta", "Тунисская Республика", "Tunus Cumhuriyeti", "Δημοκρατία της Τυνησίας", "チュニジア共和国", "突尼斯共和国", "튀니지 공화국", "الجمهورية التونسية"},
+	"TO": {"Kingdom of Tonga", "Königreich Tonga", "Royaume des Tonga", "Reino de Tonga", "Regno di Tonga", "Koninkrijk Tonga", "Królestwo Tonga", "Království Tonga", "Konungariket Tonga", "Kongeriget Tonga", "Kongeriket Tonga", "Tongan kuningaskunta", "Королевство Тонга", "Tonga Krallığı", "Βασίλειο της Τόνγκα", "トンガ王国", "汤加王国", "통가 왕국", "مملكة تونغا"},
+	"TR": {"Republic of Türkiye", "Republik Türkei", "Republiek Turkije", "Republika Turcji", "Turecká republika", "Republiken Turkiet", "Türkiye Cumhuriyeti", "土耳其共和国", "튀르키예 공화국"},
+	"TT": {"Republic of Trinidad and Tobago", "Republik Trinidad und Tobago", "République de Trinité et Tobago", "República de Trinidad y Tobago", "Repubblica di Trinidad e Tobago", "Republiek Trinidad en Tobago", "República de Trinidade e Tobago", "Republika Trynidadu i Tobago", "Republika Trinidad a Tobago", "Republiken Trinidad och Tobago", "Republikken Trinidad og Tobago", "Trinidadin ja Tobagon tasavalta", "Республика Тринидад и Тобаго", "Trinidad ve Tobago Cumhuriyeti", "Δημοκρατία Τρινιδάδ και Τομπάγκο", "トリニダード・トバゴ共和国", "特里尼达和多巴哥共和国", "트리니다드 토바고 공화국", "جمهوريّة ترينيداد و توباغو"},
+	"TW": {"Taiwan, Province of China", "Taiwan, Chinesische Provinz", "Taïwan, province de Chine", "Taiwán, Provincia de China", "Taiwan, Repubblica di Cina", "Taiwan, Província da China", "Tajwan, Prowincja Chińska", "Tchaj-wan, provincie Číny", "Taiwan, provins i Kina", "Taiwan, Den Kinesiske Provins", "Taiwan, Den kinesiske provins", "Taiwan, Kiinan provinssi", "Китайская провинция Тайвань", "Tayvan, Çin Eyaleti", "Ταϊβάν, Επαρχία της Κίνας", "中国領・台湾", "中国台湾省", "타이완, 중국령", "تايوان، محافظة صينيّة"},
+	"TZ": {"Tanzania, United Republic of", "United Republic of Tanzania", "Tansania, Vereinigte Republik", "Vereinigte Republik Tansania", "Tanzanie, République unie de", "République unie de Tanzanie", "Tanzania, República unida de", "República Unida de Tanzania", "Repubblica unita di Tanzania", "Verenigde Republiek Tanzania", "Tanzânia, República Unida da", "República Unida da Tanzânia", "Tanzania, Zjednoczona Republika", "Zjednoczona Republika Tanzanii", "Tanzanie, sjednocená republika", "Sjednocená tanzanská republika", "Tanzania, förenade republiken", "Förenade republiken Tanzania", "Tanzania, Den Forenede Republik", "Den Forenede Republik Tanzania", "Tanzania, Forbundsrepublikken", "Forbundsrepublikken Tanzania", "Tansanian yhdistynyt tasavalta", "Объединённая Республика Танзания", "Tanzanya Birleşik Cumhuriyeti", "Τανζανία, Ενωμένη Δημοκρατία της", "Ενωμένη Δημοκρατία της Τανζανίας", "タニザニア連合共和国", "タンザニア連合共和国", "坦桑尼亚联合共和国", "탄자니아 연방 공화국", "تنزانيا، جمهوريّة تنزانيا المتّحدة", "جمهوريّة تنزانيا المتّحدة"},
+	"UA": {"Україна"},
+	"UG": {"Republic of Uganda", "Republik Uganda", "République d'Ouganda", "República de Uganda", "Repubblica dell'Uganda", "Republiek Oeganda", "República do Uganda", "Republika Ugandy", "Ugandská republika", "Republiken Uganda", "Republikken Uganda", "Ugandan tasavalta", "Республика Уганда", "Uganda Cumhuriyeti", "Δημοκρατία της Ουγκάντας", "ウガンダ共和国", "乌干达共和国", "우간다 공화국", "جمهوريّة أوغندا"},
+	"US": {"United States of America", "Vereinigte Staaten von Amerika", "États-Unis d'Amérique", "Estados Unidos de América", "Stati Uniti d'America", "Verenigde Staten van Amerika", "Estados Unidos da América", "Stany Zjednoczone Ameryki", "Spojené státy americké", "Amerikas förenta stater", "Amerikas Forenede Stater", "Amerikan yhdysvallat", "Соединённые Штаты Америки", "Ηνωμένες Πολιτείες Αμερικής", "アメリカ合衆国", "美利坚合众国", "الولايات المتّحدة الأميركيّة"},
+	"UY": {"Eastern Republic of Uruguay", "Republik Östlich des Uruguay", "République orientale d'Uruguay", "República Oriental del Uruguay", "Repubblica orientale dell'Uruguay", "Oostelijke Republiek Uruguay", "República Oriental do Uruguai", "Wschodnia Republika Urugwaju", "Uruguayská východní republika", "Östra republiken Uruguay", "Den Østlige Republik Uruguay", "Republikken Uruguay", "Uruguayn itäinen tasavalta", "Восточная республика Уругвай", "Uruguay Doğu Cumhuriyeti", "Ανατολική Δημοκρατία της Ουρουγουάης", "ウルグアイ東方共和国", "乌拉圭东岸共和国", "동 우루과이 공화국", "جمهوريّة الأوروغواي الشّرقيّة"},
+	"UZ": {"Republic of Uzbekistan", "Republik Usbekistan", "République d'Ouzbékistan", "República de Uzbekistán", "Repubblica dell'Uzbekistan", "Republiek Oezbekistan", "República do Uzbequistão", "Republika Uzbekistanu", "Uzbecká republika", "Republiken Uzbekistan", "Republikken Usbekistan", "Uzbekistanin tasavalta", "Республика Узбекистан", "Özbekistan Cumhuriyeti", "Δημοκρατία του Ουζμπεκιστάν", "ウズベキスタン共和国", "乌兹别克斯坦共和国", "우즈베키스탄 공화국", "جمهوريّة أوزبكستان"},
+	"VA": {"Holy See (Vatican City State)", "Vaticaanstad, Staat"},
+	"VE": {"Venezuela, Bolivarian Republic of", "Bolivarian Republic of Venezuela", "Venezuela, Bolivarische Republik", "Bolivarische Republik Venezuela", "Vénézuela, république bolivarienne du", "République bolivarienne du Vénézuela", "Venezuela, República Bolivariana de", "República Bolivariana de Venezuela", "Venezuela, Repubblica bolivariana del", "Repubblica bolivariana del Venezuela", "Venezuela, Bolivariaanse Republiek", "Bolivariaanse Republiek Venezuela", "Venezuela, República Bolivariana da", "República Bolivariana da Venezuela", "Wenezuela - Boliwariańska Republika", "Boliwariańska Republika Wenezueli", "Bolívarovská republika Venezuela", "Venezuela, Bolivarianska republiken", "Boliviska republiken Venezuela", "Den Bolivariske Republik Venezuela", "Venezuela, Republikken", "Republikken Venezuela", "Venezuelan bolivariaanien tasavalta", "Боливарианская Республика Венесуэла", "Venezuela Bolivar Cumhuriyeti", "Βενεζουέλα, Βολιβαριανή Δημοκρατία της", "Βολιβαριανή Δημοκρατία της Βενεζουέλας", "ベネズエラ・ボリバル共和国", "委内瑞拉玻利瓦尔共和国", "베네수엘라 볼리바르 공화국", "جمهورية فنزويلا البوليفارية", "جمهوريّة فنزويلّا"},
+	"VG": {"Virgin Islands, British", "Islas Vírgenes, Británicas", "Islas Vírgenes Británicas", "Isole Vergini, Regno Unito", "Isole Vergini britanniche", "Maagdeneilanden, Britse", "Britse Maagdeneilanden", "Ilhas Virgens, Britânicas", "Ilhas Virgens Britânicas", "Panenské ostrovy, britské", "Britské Panenské ostrovy", "Jungfruöarna, brittiska", "Brittiska Jungfruöarna", "Britiske Jomfruøer, De", "De britiske Jomfruøyer", "Neitsytsaaret, Brittiläiset", "Brittiläiset Neitsytsaaret", "Британские Виргинские Острова", "Παρθένοι Νήσοι, Βρετανικές", "Βρετανικές Παρθένοι Νήσοι", "버진 제도, 영국령", "영국령 버진 제도", "جزر فيرجن البريطانيّة"},
+	"VI": {"Virgin Islands, U.S.", "Virgin Islands of the United States", "Îles Vierges, États-Unis", "Îles Vierges des États-Unis d'Amérique", "Islas Vírgenes, de EEUU", "Islas Vírgenes de los Estados Unidos", "Isole Vergini, U.S.A.", "Isole Vergini statunitensi", "Maagdeneilanden, Amerikaanse", "Amerikaanse Maagdeneilanden", "Ilhas Virgens, Estados Unidos", "Ilhas Virgens dos Estados Unidos", "Panenské ostrovy, americké", "Americké Panenské ostrovy", "Jungfruöarna, amerikanska", "Amerikanska Jungfruöarna", "Amerikanske Jomfruøer, De", "Neitsytsaaret, Yhdysvaltain", "Yhdysvaltain Neitsytsaaret", "Американские Виргинские острова", "Virgin Adaları, A.B.D.", "Amerikan Virgin Adaları", "Παρθένοι Νήσοι, Η.Π.Α.", "Παρθένοι Νήσοι των Ηνωμένων Πολιτειών", "美属维京群岛", "버진 제도, 미국령", "미국령 버진 제도", "جزر فيرجن الأميركيّة"},
+	"VN": {"Viet Nam", "Socialist Republic of Viet Nam", "Sozialistische Republik Vietnam", "République socialiste du Viet Nam", "República Socialista de Vietnam", "Repubblica socialista del Vietnam", "Socialistische Republiek Vietnam", "República Socialista do Vietname", "Socjalistyczna Republika Wietnamu", "Vietnamská socialistická republika", "Socialistrepubliken Vietnam", "Den Socialistiske Republik Vietnam", "Den sosialistiske republikk Vietnam", "Vietnamin sosialistinen tasavalta", "Социалистическая Республика Вьетнам", "Vietnam Sosyalist Cumhuriyeti", "Σοσιαλιστική Δημοκρατία του Βιετνάμ", "ベトナム社会主義共和国", "越南社会主义共和国", "베트남 사회주의 공화국", "الفييتنام", "جمهوريّة الفييتنام الاشتراكيّة", "Nước Cộng Hoà Xã Hội Việt Nam"},
+	"VU": {"Republic of Vanuatu", "Republik Vanuatu", "République du Vanuatu", "República de Vanuatu", "Repubblica di Vanuatu", "Republiek Vanuatu", "Republika Vanuatu", "Vanuatská republika", "Republiken Vanatu", "Republikken Vanuatu", "Vanuatun tasavalta", "Республика Вануату", "Vanuatu Cumhuriyeti", "Δημοκρατία του Βανουάτου", "バヌアツ共和国", "瓦努阿图共和国", "바누아투 공화국", "جمهوريّة فانواتو"},
+	"WS": {"Independent State of Samoa", "Unabhängiger Staat Samoa", "État indépendant de Samoa", "Estado Independiente de Samoa", "Stato indipendente di Samoa", "Onafhankelijke Staat Samoa", "Estado Independente de Samoa", "Niezależne Państwo Samoa", "Nezávislý stát Samoa", "Oberoende staten Samoa", "Den Uafhængige Stat Samoa", "Den uavhengige staten Samoa", "Samoan itsenäinen valtio", "Независимое Государство Самоа", "Samoa Bağımsız Devleti", "Ανεξάρτητο Κράτος της Σαμόα", "サモア独立国", "萨摩亚独立国", "دولة صاموا المستقلّة"},
+	"YE": {"Republic of Yemen", "Republik Jemen", "République du Yémen", "República del Yemen", "Repubblica dello Yemen", "Republiek Jemen", "República do Iémen", "Republika Jemenu", "Jemenská republika", "Republiken Yemen", "Republikken Yemen", "Republikken Jemen", "Jemenin tasavalta", "Йеменская Республика", "Yemen Cumhuriyeti", "Δημοκρατία της Υεμένης", "イエメン共和国", "也门共和国", "예멘 공화국", "جمهوريّة اليمن"},
+	"ZA": {"Republic of South Africa", "Republik Südafrika", "République d'Afrique du Sud", "República de Sudáfrica", "Repubblica sudafricana", "Republiek Zuid-Afrika", "República da África do Sul", "Republika Południowej Afryki", "Republiken Sydafrika", "Republikken Sydafrika", "Republikken Sør-Afrika", "Etelä-Afrikan tasavalta", "Южно-Африканская Республика", "Güney Afrika Cumhuriyeti", "Δημοκρατία της Νότιας Αφρικής", "南アフリカ共和国", "南非共和国", "جمهوريّة جنوب إفريقيا"},
+	"ZM": {"Republic of Zambia", "Republik Sambia", "République de Zambie", "República de Zambia", "Repubblica dello Zambia", "Republiek Zambia", "República da Zâmbia", "Republika Zambii", "Zambijská republika", "Republiken Zambia", "Republikken Zambia", "Sambian tasavalta", "Республика Замбия", "Zambiya Cumhuriyeti", "Δημοκρατία της Ζάμπιας", "ザンビア共和国", "赞比亚共和国", "잠비아 공화국", "جمهوريّة زامبيا"},
+	"ZW": {"Republic of Zimbabwe", "Republik Simbabwe", "République du Zimbabwe", "República de Zimbabue", "Repubblica dello Zimbabwe", "Republiek Zimbabwe", "República do Zimbábue", "Republika Zimbabwe", "Zimbabwská republika", "Republiken Zimbabwe", "Republikken Zimbabwe", "Zimbabwen tasavalta", "Республика Зимбабве", "Zimbabve Cumhuriyeti", "Δημοκρατία της Ζιμπάμπουε", "ジンバブエ共和国", "津巴布韦共和国", "짐바브웨 공화국", "جمهوريّة زمبابوي"},
+}
@@ -0,0 +1,135 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCountryNamesTestSuite(t *testing.T) {
+	suite.Run(t, new(CountryNamesTestSuite))
+}
+
+type CountryNamesTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *CountryNamesTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+}
+
+func (suite *CountryNamesTestSuite) TestResolveCountryCode() {
+	countryCodes := map[string]string{
+		"United States of America": "US",
+		"U.S.A.":                   "US",
+		"u. s. a.":                 "US",
+		"Deutschland":              "DE",
+		"España":                   "ES",
+		"ESPANA":                   "ES",
+		"the Netherlands":          "NL",
+		"Holland":                  "NL",
+		"Schweiz":                  "CH",
+		"Royaume-Uni":              "GB",
+		"日本":                       "JP",
+		"DEU":                      "DE",
+		"uk":                       "GB",
+		"fr":                       "FR",
+		"Untied States":            "US",
+		"Germnay":                  "DE",
+		"Swtizerland":              "CH",
+		"Kosovo":                   "XK",
+	}
+
+	for country, expectedCountryCode := range countryCodes {
+		countryCode, isResolved := ResolveCountryCode(country, suite.Config)
+
+		suite.True(isResolved, "%s should be resolved", country)
+		suite.Equal(expectedCountryCode, countryCode, "%s resolved to wrong country code", country)
+	}
+}
+
+func (suite *CountryNamesTestSuite) TestResolveCountryCodeUnknown() {
+	for _, country := range []string{"", " ", "Atlantis", "Korea", "xyz"} {
+		_, isResolved := ResolveCountryCode(country, suite.Config)
+
+		suite.False(isResolved, "%s should not be resolved", country)
+	}
+}
+
+// linearFuzzyMatch is the scan over all names the neighbours of the index replace
+func linearFuzzyMatch(index *countryNameIndex, key string) (string, bool) {
+	matchedCode := ""
+	for name, code := range index.codes {
+		if code == "" || code == matchedCode || utf8.RuneCountInString(key) < fuzzyCountryNameMinLength || !isWithinOneEdit(key, name) {
+			continue
+		}
+		if matchedCode != "" {
+			return "", false
+		}
+		matchedCode = code
+	}
+
+	return matchedCode, matchedCode != ""
+}
+
+func (suite *CountryNamesTestSuite) TestFuzzyMatchFindsAllTypos() {
+	index := getCountryNameIndex()
+
+	for _, name := range []string{"germany", "switzerland", "united states", "osterreich", "nederland", "south africa", "rossiia"} {
+		runes := []rune(name)
+		var typos []string
+		for i := range runes {
+			typos = append(typos, string(runes[:i])+string(runes[i+1:]), string(runes[:i])+"x"+string(runes[i+1:]), string(runes[:i])+"q"+string(runes[i:]))
+			if i+1 < len(runes) {
+				typos = append(typos, string(runes[:i])+string(runes[i+1])+string(runes[i])+string(runes[i+2:]))
+			}
+		}
+
+		for _, typo := range typos {
+			expectedCode, expectedIsMatched := linearFuzzyMatch(index, typo)
+			code, isMatched := index.fuzzyMatch(typo)
+
+			suite.Equal(expectedIsMatched, isMatched, typo)
+			suite.Equal(expectedCode, code, typo)
+		}
+	}
+}
+
+func (suite *CountryNamesTestSuite) TestGetFixedAddressResolvesCountryName() {
+	addressMap := addressMap{
+		"road":         "Platz der Republik",
+		"house_number": "1",
+		"postcode":     "11011",
+		"city":         "Berlin",
+		"country":      "Deutschland",
+	}
+
+	address, err := GetFixedAddress(addressMap, suite.Config)
+	suite.NoError(err)
+	suite.Empty(address.CountryCode, "Country names should only be resolved if enabled")
+
+	suite.Config.ResolveCountryNames = true
+	address, err = GetFixedAddress(addressMap, suite.Config)
+	suite.NoError(err)
+	suite.Equal("DE", address.CountryCode)
+
+	addressMap["country_code"] = "us"
+	address, err = GetFixedAddress(addressMap, suite.Config)
+	suite.NoError(err)
+	suite.Equal("US", address.CountryCode, "Provided country code should not be overwritten")
+}
+
+func BenchmarkResolveCountryCode(b *testing.B) {
+	getCountryNameIndex()
+	countries := map[string]string{"hit": "Deutschland", "typo": "Swtizerland", "miss": "Republic of Atlantis"}
+
+	for name, country := range countries {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ResolveCountryCode(country, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package addrFmt
+
+import (
+	"fmt"
+	"github.com/cbroglie/mustache"
+	"strings"
+)
+
+// AddressForm describes the fields of an address form in the order and on the lines the address_template of the
+// country renders them
+type AddressForm struct {
+	CountryCode string      `json:"country_code"`
+	Language    string      `json:"language"`
+	Fields      []FormField `json:"fields"`
+}
+
+// FormField is a component of the address template
+type FormField struct {
+	Component string `json:"component"`
+	Label     string `json:"label"`
+	// Line is the index of the line the component is rendered on, components on the same line share it
+	Line int `json:"line"`
+	// Conditional is set for the alternatives of a {{#first}} section, only the first one with a value is rendered
+	Conditional bool `json:"conditional"`
+	// Alternatives are all components of the {{#first}} section in template order
+	Alternatives []string `json:"alternatives,omitempty"`
+}
+
+// formFieldMarker encloses the components when the template is rendered to find their lines
+const formFieldMarker = "\x00"
+
+// GetAddressForm derives the form fields of a country from its address_template, a country with use_country gets the
+// fields of that country. The labels are in the language of WithLanguage or the main language of the country and in
+// english if there is no translation, WithScript chooses a language variant of the template such as CN_zh
+func GetAddressForm(countryCode string, config *Config, opts ...Option) (*AddressForm, error) {
+	options := newOptions(opts)
+	countryCode = getFixedCountryCode(countryCode)
+
+	templates := config.getTemplates()
+	template := findScriptTemplate(countryCode, getCountryScript(countryCode, options.script), templates)
+	countryTemplate, _ := template.(map[string]interface{})
+	if useCountry, hasUseCountry := countryTemplate["use_country"].(string); hasUseCountry {
+		useCountry = strings.ToUpper(useCountry)
+		template = findScriptTemplate(useCountry, getCountryScript(useCountry, options.script), templates)
+	}
+
+	var templateText string
+	var err error
+	if templateValue, isTemplateMap := template.(map[string]interface{}); isTemplateMap {
+		templateText, err = findTemplateText(templateValue, "address_template", templates)
+	} else {
+		templateText, err = getTemplateText(template, "address_template")
+	}
+	var fields []FormField
+	if err == nil {
+		fields, err = getFormFields(templateText, templates.cache)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid template of %s: %w", countryCode, err)
+	}
+
+	language := options.language
+	if language == "" {
+		language = getLanguage(countryCode, config)
+	}
+
+	form := &AddressForm{CountryCode: countryCode, Language: language, Fields: fields}
+	for i := range form.Fields {
+		form.Fields[i].Label = getComponentLabel(form.Fields[i].Component, countryCode, language)
+	}
+
+	return form, nil
+}
+
+// GetAddressForms derives the forms of all countries with a template, e.g. to export them as JSON
+func GetAddressForms(config *Config, opts ...Option) (map[string]*AddressForm, error) {
+	countryCodes := config.Countries()
+	forms := make(map[string]*AddressForm, len(countryCodes))
+	for _, countryCode := range countryCodes {
+		form, err := GetAddressForm(countryCode, config, opts...)
+		if err != nil {
+			return nil, err
+		}
+		forms[countryCode] = form
+	}
+
+	return forms, nil
+}
+
+// getFormFields collects the variables of the template with the index of their line among the lines with variables,
+// a repeated component keeps its first position
+func getFormFields(templateText string, cache *configCache) ([]FormField, error) {
+	parsedTemplate, err := cache.parseTemplate(templateText)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []FormField
+	seen := make(map[string]bool)
+
+	var collect func(tags []mustache.Tag, isConditional bool)
+	collect = func(tags []mustache.Tag, isConditional bool) {
+		for _, tag := range tags {
+			switch {
+			case tag.Type() == mustache.Variable && !seen[tag.Name()]:
+				seen[tag.Name()] = true
+				fields = append(fields, FormField{Component: tag.Name(), Conditional: isConditional})
+			case tag.Type() == mustache.Section && tag.Name() == "first" && !isConditional:
+				firstSectionStart := len(fields)
+				collect(tag.Tags(), true)
+				setAlternatives(fields[firstSectionStart:])
+			case tag.Type() == mustache.Section || tag.Type() == mustache.InvertedSection:
+				collect(tag.Tags(), isConditional)
+			}
+		}
+	}
+	collect(parsedTemplate.Tags(), false)
+
+	lines, err := getComponentLines(parsedTemplate, fields)
+	if err != nil {
+		return nil, err
+	}
+	line := 0
+	for i := range fields {
+		// components that are not rendered, e.g. in an inverted section, stay on the line of the previous field
+		if componentLine, isRendered := lines[fields[i].Component]; isRendered {
+			line = componentLine
+		}
+		fields[i].Line = line
+	}
+
+	return fields, nil
+}
+
+// getComponentLines renders the template with a marker for every component including all alternatives of the
+// {{#first}} sections and returns the index of the line of each component among the lines with components
+func getComponentLines(parsedTemplate *mustache.Template, fields []FormField) (map[string]int, error) {
+	input := make(map[string]interface{}, len(fields)+1)
+	for _, field := range fields {
+		input[field.Component] = formFieldMarker + field.Component + formFieldMarker
+	}
+	input["first"] = func(text string, render mustache.RenderFunc) (string, error) {
+		return render(text)
+	}
+
+	render, err := parsedTemplate.Render(input)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(map[string]int, len(fields))
+	line := 0
+	for _, renderLine := range strings.Split(render, "\n") {
+		parts := strings.Split(renderLine, formFieldMarker)
+		// the components are the odd parts between the markers
+		for i := 1; i < len(parts); i += 2 {
+			if _, isSeen := lines[parts[i]]; !isSeen {
+				lines[parts[i]] = line
+			}
+		}
+		if len(parts) > 1 {
+			line++
+		}
+	}
+
+	return lines, nil
+}
+
+func setAlternatives(fields []FormField) {
+	alternatives := make([]string, len(fields))
+	for i, field := range fields {
+		alternatives[i] = field.Component
+	}
+
+	for i := range fields {
+		fields[i].Alternatives = alternatives
+	}
+}
+
+// getComponentLabel returns the label of the language in the country (e.g. en-US), of the language or the english label
+// of the component
+func getComponentLabel(component string, countryCode string, language string) string {
+	if label, hasLabel := regionalComponentLabels[language+"-"+countryCode][component]; hasLabel {
+		return label
+	}
+
+	labels := componentLabels[component]
+	if label, hasLabel := labels[language]; hasLabel {
+		return label
+	}
+	if label, hasLabel := labels["en"]; hasLabel {
+		return label
+	}
+
+	return component
+}
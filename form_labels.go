@@ -0,0 +1,266 @@
+package addrFmt
+
+// componentLabels holds the form label of every address component per language
+var componentLabels = map[string]map[string]string{
+	"attention": {
+		"en": "Name",
+		"de": "Name",
+		"fr": "Nom",
+		"es": "Nombre",
+		"it": "Nome",
+		"nl": "Naam",
+		"pt": "Nome",
+	},
+	"house": {
+		"en": "Building",
+		"de": "Gebäude",
+		"fr": "Bâtiment",
+		"es": "Edificio",
+		"it": "Edificio",
+		"nl": "Gebouw",
+		"pt": "Edifício",
+	},
+	"house_number": {
+		"en": "House number",
+		"de": "Hausnummer",
+		"fr": "Numéro",
+		"es": "Número",
+		"it": "Numero civico",
+		"nl": "Huisnummer",
+		"pt": "Número",
+	},
+	"road": {
+		"en": "Street",
+		"de": "Straße",
+		"fr": "Rue",
+		"es": "Calle",
+		"it": "Via",
+		"nl": "Straat",
+		"pt": "Rua",
+	},
+	"hamlet": {
+		"en": "Hamlet",
+		"de": "Weiler",
+		"fr": "Hameau",
+		"es": "Aldea",
+		"it": "Frazione",
+		"nl": "Gehucht",
+		"pt": "Lugar",
+	},
+	"village": {
+		"en": "Village",
+		"de": "Dorf",
+		"fr": "Village",
+		"es": "Pueblo",
+		"it": "Paese",
+		"nl": "Dorp",
+		"pt": "Aldeia",
+	},
+	"neighbourhood": {
+		"en": "Neighbourhood",
+		"de": "Viertel",
+		"fr": "Quartier",
+		"es": "Barrio",
+		"it": "Quartiere",
+		"nl": "Buurt",
+		"pt": "Bairro",
+	},
+	"postal_city": {
+		"en": "Post town",
+		"de": "Postort",
+		"fr": "Bureau distributeur",
+		"es": "Localidad postal",
+		"it": "Località postale",
+		"nl": "Postplaats",
+		"pt": "Localidade postal",
+	},
+	"city": {
+		"en": "City",
+		"de": "Stadt",
+		"fr": "Ville",
+		"es": "Ciudad",
+		"it": "Città",
+		"nl": "Plaats",
+		"pt": "Cidade",
+	},
+	"city_district": {
+		"en": "District",
+		"de": "Stadtbezirk",
+		"fr": "Arrondissement",
+		"es": "Distrito",
+		"it": "Municipio",
+		"nl": "Stadsdeel",
+		"pt": "Distrito",
+	},
+	"municipality": {
+		"en": "Municipality",
+		"de": "Gemeinde",
+		"fr": "Commune",
+		"es": "Municipio",
+		"it": "Comune",
+		"nl": "Gemeente",
+		"pt": "Município",
+	},
+	"county": {
+		"en": "County",
+		"de": "Landkreis",
+		"fr": "Département",
+		"es": "Provincia",
+		"it": "Provincia",
+		"nl": "Regio",
+		"pt": "Concelho",
+	},
+	"county_code": {
+		"en": "County code",
+		"de": "Kreiskennzeichen",
+		"fr": "Code du département",
+		"es": "Código de provincia",
+		"it": "Sigla della provincia",
+		"nl": "Regiocode",
+		"pt": "Código do concelho",
+	},
+	"state_district": {
+		"en": "Region",
+		"de": "Regierungsbezirk",
+		"fr": "Arrondissement",
+		"es": "Comarca",
+		"it": "Circondario",
+		"nl": "District",
+		"pt": "Sub-região",
+	},
+	"postcode": {
+		"en": "Postal code",
+		"de": "Postleitzahl",
+		"fr": "Code postal",
+		"es": "Código postal",
+		"it": "CAP",
+		"nl": "Postcode",
+		"pt": "Código postal",
+	},
+	"state": {
+		"en": "State",
+		"de": "Bundesland",
+		"fr": "Région",
+		"es": "Comunidad autónoma",
+		"it": "Regione",
+		"nl": "Provincie",
+		"pt": "Estado",
+	},
+	"state_code": {
+		"en": "State code",
+		"de": "Bundeslandkürzel",
+		"fr": "Code de la région",
+		"es": "Código de la comunidad",
+		"it": "Sigla della regione",
+		"nl": "Provinciecode",
+		"pt": "Sigla do estado",
+	},
+	"region": {
+		"en": "Region",
+		"de": "Region",
+		"fr": "Région",
+		"es": "Región",
+		"it": "Regione",
+		"nl": "Regio",
+		"pt": "Região",
+	},
+	"suburb": {
+		"en": "Suburb",
+		"de": "Stadtteil",
+		"fr": "Quartier",
+		"es": "Barrio",
+		"it": "Quartiere",
+		"nl": "Wijk",
+		"pt": "Bairro",
+	},
+	"quarter": {
+		"en": "Quarter",
+		"de": "Quartier",
+		"fr": "Quartier",
+		"es": "Barrio",
+		"it": "Rione",
+		"nl": "Kwartier",
+		"pt": "Bairro",
+	},
+	"residential": {
+		"en": "Residential area",
+		"de": "Wohngebiet",
+		"fr": "Lotissement",
+		"es": "Urbanización",
+		"it": "Zona residenziale",
+		"nl": "Woonwijk",
+		"pt": "Urbanização",
+	},
+	"town": {
+		"en": "Town",
+		"de": "Ort",
+		"fr": "Ville",
+		"es": "Localidad",
+		"it": "Città",
+		"nl": "Plaats",
+		"pt": "Vila",
+	},
+	"island": {
+		"en": "Island",
+		"de": "Insel",
+		"fr": "Île",
+		"es": "Isla",
+		"it": "Isola",
+		"nl": "Eiland",
+		"pt": "Ilha",
+	},
+	"archipelago": {
+		"en": "Archipelago",
+		"de": "Inselgruppe",
+		"fr": "Archipel",
+		"es": "Archipiélago",
+		"it": "Arcipelago",
+		"nl": "Archipel",
+		"pt": "Arquipélago",
+	},
+	"country": {
+		"en": "Country",
+		"de": "Land",
+		"fr": "Pays",
+		"es": "País",
+		"it": "Paese",
+		"nl": "Land",
+		"pt": "País",
+	},
+	"country_code": {
+		"en": "Country code",
+		"de": "Ländercode",
+		"fr": "Code du pays",
+		"es": "Código del país",
+		"it": "Codice del paese",
+		"nl": "Landcode",
+		"pt": "Código do país",
+	},
+	"continent": {
+		"en": "Continent",
+		"de": "Kontinent",
+		"fr": "Continent",
+		"es": "Continente",
+		"it": "Continente",
+		"nl": "Continent",
+		"pt": "Continente",
+	},
+}
+
+// regionalComponentLabels holds the labels that differ in a country (language-country code)
+var regionalComponentLabels = map[string]map[string]string{
+	"en-US": {"postcode": "ZIP code", "state_code": "State"},
+	"en-GB": {"postcode": "Postcode", "city": "Town/City", "postal_city": "Post town"},
+	"en-IE": {"postcode": "Eircode"},
+	"en-IN": {"postcode": "PIN code"},
+	"en-CA": {"state": "Province", "state_code": "Province"},
+	"fr-CA": {"state": "Province", "state_code": "Province"},
+	"de-AT": {"county": "Bezirk"},
+	"de-CH": {"state": "Kanton", "state_code": "Kanton"},
+	"fr-CH": {"state": "Canton", "state_code": "Canton"},
+	"it-CH": {"state": "Cantone", "state_code": "Cantone"},
+	"fr-FR": {"state": "Région", "county": "Département"},
+	"es-ES": {"state": "Comunidad autónoma", "county": "Provincia"},
+	"es-MX": {"state": "Estado", "state_code": "Estado", "county": "Municipio"},
+	"pt-BR": {"postcode": "CEP", "state_code": "Estado"},
+}
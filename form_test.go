@@ -0,0 +1,135 @@
+package addrFmt
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestFormFieldsTestSuite(t *testing.T) {
+	suite.Run(t, new(FormFieldsTestSuite))
+}
+
+type FormFieldsTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *FormFieldsTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+}
+
+func (suite *FormFieldsTestSuite) form(countryCode string, opts ...Option) *AddressForm {
+	form, err := GetAddressForm(countryCode, suite.Config, opts...)
+	suite.Require().NoError(err)
+
+	return form
+}
+
+func (suite *FormFieldsTestSuite) TestFields() {
+	form := suite.form("us")
+
+	suite.Equal("US", form.CountryCode)
+	suite.Equal("en", form.Language)
+
+	cityAlternatives := []string{"village", "hamlet", "city", "town", "municipality", "county"}
+	stateAlternatives := []string{"state_code", "state"}
+	suite.Equal([]FormField{
+		{Component: "attention", Label: "Name", Line: 0},
+		{Component: "house", Label: "Building", Line: 1},
+		{Component: "house_number", Label: "House number", Line: 2},
+		{Component: "road", Label: "Street", Line: 2},
+		{Component: "village", Label: "Village", Line: 3, Conditional: true, Alternatives: cityAlternatives},
+		{Component: "hamlet", Label: "Hamlet", Line: 3, Conditional: true, Alternatives: cityAlternatives},
+		{Component: "city", Label: "City", Line: 3, Conditional: true, Alternatives: cityAlternatives},
+		{Component: "town", Label: "Town", Line: 3, Conditional: true, Alternatives: cityAlternatives},
+		{Component: "municipality", Label: "Municipality", Line: 3, Conditional: true, Alternatives: cityAlternatives},
+		{Component: "county", Label: "County", Line: 3, Conditional: true, Alternatives: cityAlternatives},
+		{Component: "state_code", Label: "State", Line: 3, Conditional: true, Alternatives: stateAlternatives},
+		{Component: "state", Label: "State", Line: 3, Conditional: true, Alternatives: stateAlternatives},
+		{Component: "postcode", Label: "ZIP code", Line: 3},
+		{Component: "country", Label: "Country", Line: 4},
+	}, form.Fields)
+}
+
+func (suite *FormFieldsTestSuite) TestLabels() {
+	form := suite.form("DE")
+	suite.Equal("de", form.Language)
+	suite.Equal("Straße", form.Fields[2].Label)
+	suite.Equal("Postleitzahl", form.Fields[4].Label)
+
+	form = suite.form("CH", WithLanguage("fr"))
+	suite.Equal("fr", form.Language)
+	suite.Equal("Rue", form.Fields[2].Label)
+	suite.Equal("Canton", form.Fields[len(form.Fields)-3].Label)
+
+	// without a translation the labels are in english
+	form = suite.form("DE", WithLanguage("sv"))
+	suite.Equal("Street", form.Fields[2].Label)
+}
+
+func (suite *FormFieldsTestSuite) TestUseCountry() {
+	form := suite.form("IC")
+
+	suite.Equal("IC", form.CountryCode)
+	suite.Equal(suite.form("ES").Fields[2].Component, form.Fields[2].Component)
+	suite.Equal("road", form.Fields[2].Component)
+}
+
+func (suite *FormFieldsTestSuite) TestScript() {
+	form := suite.form("CN", WithScript(ScriptHanSimplified))
+
+	suite.Equal("country", form.Fields[0].Component)
+	suite.Equal(0, form.Fields[0].Line)
+	suite.Equal("attention", form.Fields[len(form.Fields)-1].Component)
+	suite.Equal(4, form.Fields[len(form.Fields)-1].Line)
+}
+
+func (suite *FormFieldsTestSuite) TestSections() {
+	form := suite.form("JP", WithScript(ScriptJapanese))
+
+	lines := make(map[string]int)
+	for _, field := range form.Fields {
+		lines[field.Component] = field.Line
+	}
+	// the postcode is rendered in a section of the same name
+	suite.Equal(map[string]int{
+		"country": 0, "postcode": 1, "state": 2, "city": 2, "town": 2, "municipality": 2, "village": 2, "city_district": 2,
+		"suburb": 2, "quarter": 2, "neighbourhood": 2, "road": 2, "house_number": 2, "house": 3, "attention": 4,
+	}, lines)
+
+	fields, err := getFormFields("{{ road }} {{{house_number}}}\n{{#first}}\n{{{city}}} || {{{town}}}\n{{/first}}\n{{^postcode}}{{{state}}}{{/postcode}}\n", nil)
+	suite.Require().NoError(err)
+	suite.Equal([]FormField{
+		{Component: "road", Line: 0},
+		{Component: "house_number", Line: 0},
+		{Component: "city", Line: 1, Conditional: true, Alternatives: []string{"city", "town"}},
+		{Component: "town", Line: 1, Conditional: true, Alternatives: []string{"city", "town"}},
+		{Component: "state", Line: 2},
+	}, fields)
+}
+
+func (suite *FormFieldsTestSuite) TestJSON() {
+	forms, err := GetAddressForms(suite.Config)
+	suite.Require().NoError(err)
+	suite.Contains(forms, "DE")
+	suite.Contains(forms, "UK")
+	suite.NotContains(forms, "default")
+	suite.NotContains(forms, "CN_zh")
+
+	encoded, err := json.Marshal(forms["GB"])
+	suite.Require().NoError(err)
+	suite.Contains(string(encoded), `{"country_code":"GB","language":"en","fields":[{"component":"attention","label":"Name","line":0,"conditional":false},`)
+	suite.Contains(string(encoded), `{"component":"postcode","label":"Postcode","line":4,"conditional":false}`)
+	suite.Contains(string(encoded), `"alternatives":["postal_city","town","city","village","hamlet","municipality"]`)
+}
+
+func (suite *FormFieldsTestSuite) TestInvalidTemplate() {
+	config, err := ParseConfig(ConfigData{Countries: []byte("DE:\n  address_template: \"{{#first}}\"\n")})
+	suite.Require().NoError(err)
+
+	_, err = GetAddressForm("DE", config)
+	suite.Error(err)
+	_, err = GetAddressForms(config)
+	suite.Error(err)
+}
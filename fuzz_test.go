@@ -0,0 +1,186 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"os"
+	"testing"
+)
+
+func readFuzzFile(f *testing.F, path string) []byte {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	return content
+}
+
+func FuzzGetFixedAddress(f *testing.F) {
+	config := readTestConfig()
+	config.ResolveCountryNames = true
+	config.AddContinent = true
+
+	f.Add("de", "Deutschland", "Berlin", "Straße", "Platz der Republik", "11011", "DE-BE")
+	f.Add("nl", "", "Curaçao", "street", "Kaya Grandi", "", "")
+	f.Add("us", "", "Washington, D.C.", "road", "Pennsylvania Avenue", "20500,20501", "")
+	f.Add("", "Spain", "", "city=Madrid", "$state", "1;2", "US-")
+
+	f.Fuzz(func(t *testing.T, countryCode string, country string, state string, key string, value string, postcode string, stateCode string) {
+		address, err := GetFixedAddress(map[string]string{
+			"country_code": countryCode,
+			"country":      country,
+			"state":        state,
+			"state_code":   stateCode,
+			"postcode":     postcode,
+			key:            value,
+		}, config, WithLanguage("de"))
+
+		if err == nil && address == nil {
+			t.Error("no address and no error")
+		}
+	})
+}
+
+func FuzzMapToAddress(f *testing.F) {
+	config := readTestConfig()
+
+	f.Add("street", "Platz der Republik", "plz", "11011", true)
+	f.Add("road", "", "attention", "Bundestag", false)
+
+	f.Fuzz(func(t *testing.T, key string, value string, otherKey string, otherValue string, unknownAsAttention bool) {
+		addressMap := map[string]string{key: value, otherKey: otherValue}
+
+		if address := MapToAddress(addressMap, config.ComponentAliases, unknownAsAttention); address == nil {
+			t.Error("no address")
+		}
+		if len(addressMap) > 2 {
+			t.Error("address map has been modified")
+		}
+	})
+}
+
+func FuzzFormatAddress(f *testing.F) {
+	config := readTestConfig()
+	config.Abbreviate = true
+
+	f.Add("DE", "Platz der Republik", "1", "11011", "Berlin", "", "de", int(PostalFormat))
+	f.Add("JP", "", "", "100-0001", "東京", "千代田区", "", int(OneLine))
+	f.Add("", "Carrera de San Jerónimo", "39", "", "", "{{#first}}", "es", int(Array))
+	f.Add("xx", "new york, new york", "", "", "New York", "", "", 42)
+
+	f.Fuzz(func(t *testing.T, countryCode string, road string, houseNumber string, postcode string, city string, state string, language string, outputFormat int) {
+		config := *config
+		config.OutputFormat = OutputFormat(outputFormat)
+
+		_, _ = FormatAddress(&Address{
+			CountryCode: countryCode,
+			Road:        road,
+			HouseNumber: houseNumber,
+			Postcode:    postcode,
+			City:        city,
+			State:       state,
+		}, &config, WithLanguage(language), WithScript(ScriptAuto))
+	})
+}
+
+func FuzzParseConfig(f *testing.F) {
+	f.Add(readFuzzFile(f, "testdata/conf/countries/worldwide.yaml"), readFuzzFile(f, "testdata/conf/components.yaml"), readFuzzFile(f, "testdata/conf/abbreviations/de.yaml"))
+	f.Add([]byte("default:\n  address_template: 42\nDE:\n  replace: [[1, 2], \"x\"]\n  postformat_replace: [[\"(\", \"\"]]\n"), []byte("name: road\naliases: [street]\n"), []byte("road: [Straße]"))
+	f.Add([]byte("DE: {{{road}}}\ndefault: [1]"), []byte("---\n---"), []byte{})
+
+	f.Fuzz(func(t *testing.T, countries []byte, components []byte, abbreviations []byte) {
+		config, err := ParseConfig(ConfigData{Countries: countries, Components: components, Abbreviations: map[string][]byte{"de": abbreviations}})
+		if err != nil {
+			return
+		}
+		config.Abbreviate = true
+		_ = config.Validate()
+
+		address, err := GetFixedAddress(map[string]string{"street": "Platz der Republik", "city": "Berlin", "country_code": "DE"}, config)
+		if err != nil {
+			return
+		}
+		_, _ = FormatAddress(address, config, WithLanguage("de"))
+	})
+}
+
+func TestMalformedConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(MalformedConfigTestSuite))
+}
+
+type MalformedConfigTestSuite struct {
+	suite.Suite
+	Address *Address
+}
+
+func (suite *MalformedConfigTestSuite) SetupTest() {
+	suite.Address = &Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", CountryCode: "DE"}
+}
+
+func (suite *MalformedConfigTestSuite) parse(countries string) *Config {
+	config, err := ParseConfig(ConfigData{Countries: []byte(countries)})
+	suite.Require().NoError(err)
+
+	return config
+}
+
+func (suite *MalformedConfigTestSuite) TestParseConfig() {
+	config, err := ParseConfig(ConfigData{
+		Countries:     []byte("default:\n  address_template: \"{{{road}}} {{{house_number}}}\"\n  fallback_template: \"{{{road}}}\"\n"),
+		Components:    []byte("name: road\naliases:\n  - street\n---\nname: postcode\naliases:\n  - plz\n"),
+		Abbreviations: map[string][]byte{"de": []byte("road:\n  Platz: Pl.\n")},
+	})
+	suite.Require().NoError(err)
+	suite.NoError(config.Validate())
+	suite.Equal("Pl.", config.Abbreviations["de"]["road"]["Platz"])
+
+	address, err := GetFixedAddress(map[string]string{"street": "Platz der Republik", "plz": "11011"}, config)
+	suite.NoError(err)
+	suite.Equal("11011", address.Postcode)
+
+	_, err = ParseConfig(ConfigData{Countries: []byte("default: [")})
+	suite.Error(err)
+	_, err = ParseConfig(ConfigData{Components: []byte("name: [road]")})
+	suite.Error(err)
+}
+
+func (suite *MalformedConfigTestSuite) TestTemplates() {
+	templates := map[string]string{
+		"no default":              "DE:\n  fallback_template: \"{{{road}}}\"\n",
+		"template is no string":   "DE:\n  address_template: 42\n",
+		"template does not parse": "DE:\n  address_template: \"{{#first}}\"\n",
+		"template is a list":      "DE: [1]\n",
+	}
+
+	for name, countries := range templates {
+		_, err := FormatAddress(suite.Address, suite.parse(countries))
+		suite.Error(err, name)
+	}
+
+	_, err := FormatAddress(suite.Address, &Config{})
+	suite.Error(err)
+}
+
+func (suite *MalformedConfigTestSuite) TestReplacements() {
+	config := suite.parse("DE:\n  address_template: \"{{{road}}}\"\n  replace: [[\"road\", 1]]\n  postformat_replace: [\"road\"]\n")
+
+	_, err := GetFixedAddress(map[string]string{"road": "Platz der Republik", "country_code": "DE"}, config)
+	suite.EqualError(err, "invalid replace: replacement 0: rule is not a pair of strings")
+
+	_, err = FormatAddress(suite.Address, config)
+	suite.EqualError(err, "invalid postformat_replace: replacement 0: rule is not a pair")
+}
+
+func (suite *MalformedConfigTestSuite) TestComponentsAreNoPatterns() {
+	config := suite.parse("DE:\n  replace: [[\"road(1)=Platz\", \"Pl.\"]]\n  change_country: \"$state\"\n")
+
+	addressMap := map[string]string{"road(1)": "Platz", "state": "$1 Land", "country_code": "DE"}
+	address, err := GetFixedAddressInPlace(addressMap, config)
+	suite.NoError(err)
+	suite.Equal("Pl.", addressMap["road(1)"])
+	suite.Equal("$1 Land", address.Country)
+
+	address, err = GetFixedAddress(map[string]string{"country_code": "DE"}, config)
+	suite.NoError(err)
+	suite.Equal("", address.Country)
+}
@@ -4,11 +4,9 @@ go 1.18
 
 require (
 	github.com/cbroglie/mustache v1.4.0
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.7.0
 	gopkg.in/yaml.v3 v3.0.1
 )
 
-require (
-	github.com/davecgh/go-spew v1.1.0 // indirect
-	github.com/pmezard/go-difflib v1.0.0 // indirect
-)
+require github.com/davecgh/go-spew v1.1.0 // indirect
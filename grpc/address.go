@@ -0,0 +1,70 @@
+package addrfmtgrpc
+
+import (
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/grpc/addrfmtpb"
+)
+
+func toProtoAddress(address *addrFmt.Address) *addrfmtpb.Address {
+	return &addrfmtpb.Address{
+		Attention:     address.Attention,
+		House:         address.House,
+		HouseNumber:   address.HouseNumber,
+		Road:          address.Road,
+		Hamlet:        address.Hamlet,
+		Village:       address.Village,
+		Neighbourhood: address.Neighbourhood,
+		PostalCity:    address.PostalCity,
+		City:          address.City,
+		CityDistrict:  address.CityDistrict,
+		Municipality:  address.Municipality,
+		County:        address.County,
+		CountyCode:    address.CountyCode,
+		StateDistrict: address.StateDistrict,
+		Postcode:      address.Postcode,
+		State:         address.State,
+		StateCode:     address.StateCode,
+		Region:        address.Region,
+		Suburb:        address.Suburb,
+		Quarter:       address.Quarter,
+		Residential:   address.Residential,
+		Town:          address.Town,
+		Island:        address.Island,
+		Archipelago:   address.Archipelago,
+		Country:       address.Country,
+		CountryCode:   address.CountryCode,
+		Continent:     address.Continent,
+	}
+}
+
+func fromProtoAddress(address *addrfmtpb.Address) *addrFmt.Address {
+	return &addrFmt.Address{
+		Attention:     address.GetAttention(),
+		House:         address.GetHouse(),
+		HouseNumber:   address.GetHouseNumber(),
+		Road:          address.GetRoad(),
+		Hamlet:        address.GetHamlet(),
+		Village:       address.GetVillage(),
+		Neighbourhood: address.GetNeighbourhood(),
+		PostalCity:    address.GetPostalCity(),
+		City:          address.GetCity(),
+		CityDistrict:  address.GetCityDistrict(),
+		Municipality:  address.GetMunicipality(),
+		County:        address.GetCounty(),
+		CountyCode:    address.GetCountyCode(),
+		StateDistrict: address.GetStateDistrict(),
+		Postcode:      address.GetPostcode(),
+		State:         address.GetState(),
+		StateCode:     address.GetStateCode(),
+		Region:        address.GetRegion(),
+		Suburb:        address.GetSuburb(),
+		Quarter:       address.GetQuarter(),
+		Residential:   address.GetResidential(),
+		Town:          address.GetTown(),
+		Island:        address.GetIsland(),
+		Archipelago:   address.GetArchipelago(),
+		Country:       address.GetCountry(),
+		CountryCode:   address.GetCountryCode(),
+		Continent:     address.GetContinent(),
+	}
+}
@@ -0,0 +1,990 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: addrfmt.proto
+
+package addrfmtpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OutputFormat int32
+
+const (
+	OutputFormat_OUTPUT_FORMAT_UNSPECIFIED OutputFormat = 0 // postal format
+	OutputFormat_OUTPUT_FORMAT_POSTAL      OutputFormat = 1
+	OutputFormat_OUTPUT_FORMAT_ONE_LINE    OutputFormat = 2
+	OutputFormat_OUTPUT_FORMAT_ARRAY       OutputFormat = 3
+)
+
+// Enum value maps for OutputFormat.
+var (
+	OutputFormat_name = map[int32]string{
+		0: "OUTPUT_FORMAT_UNSPECIFIED",
+		1: "OUTPUT_FORMAT_POSTAL",
+		2: "OUTPUT_FORMAT_ONE_LINE",
+		3: "OUTPUT_FORMAT_ARRAY",
+	}
+	OutputFormat_value = map[string]int32{
+		"OUTPUT_FORMAT_UNSPECIFIED": 0,
+		"OUTPUT_FORMAT_POSTAL":      1,
+		"OUTPUT_FORMAT_ONE_LINE":    2,
+		"OUTPUT_FORMAT_ARRAY":       3,
+	}
+)
+
+func (x OutputFormat) Enum() *OutputFormat {
+	p := new(OutputFormat)
+	*p = x
+	return p
+}
+
+func (x OutputFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutputFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_addrfmt_proto_enumTypes[0].Descriptor()
+}
+
+func (OutputFormat) Type() protoreflect.EnumType {
+	return &file_addrfmt_proto_enumTypes[0]
+}
+
+func (x OutputFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutputFormat.Descriptor instead.
+func (OutputFormat) EnumDescriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{0}
+}
+
+// Address has the components of address.go.
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attention     string                 `protobuf:"bytes,1,opt,name=attention,proto3" json:"attention,omitempty"`
+	House         string                 `protobuf:"bytes,2,opt,name=house,proto3" json:"house,omitempty"`
+	HouseNumber   string                 `protobuf:"bytes,3,opt,name=house_number,json=houseNumber,proto3" json:"house_number,omitempty"`
+	Road          string                 `protobuf:"bytes,4,opt,name=road,proto3" json:"road,omitempty"`
+	Hamlet        string                 `protobuf:"bytes,5,opt,name=hamlet,proto3" json:"hamlet,omitempty"`
+	Village       string                 `protobuf:"bytes,6,opt,name=village,proto3" json:"village,omitempty"`
+	Neighbourhood string                 `protobuf:"bytes,7,opt,name=neighbourhood,proto3" json:"neighbourhood,omitempty"`
+	PostalCity    string                 `protobuf:"bytes,8,opt,name=postal_city,json=postalCity,proto3" json:"postal_city,omitempty"`
+	City          string                 `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	CityDistrict  string                 `protobuf:"bytes,10,opt,name=city_district,json=cityDistrict,proto3" json:"city_district,omitempty"`
+	Municipality  string                 `protobuf:"bytes,11,opt,name=municipality,proto3" json:"municipality,omitempty"`
+	County        string                 `protobuf:"bytes,12,opt,name=county,proto3" json:"county,omitempty"`
+	CountyCode    string                 `protobuf:"bytes,13,opt,name=county_code,json=countyCode,proto3" json:"county_code,omitempty"`
+	StateDistrict string                 `protobuf:"bytes,14,opt,name=state_district,json=stateDistrict,proto3" json:"state_district,omitempty"`
+	Postcode      string                 `protobuf:"bytes,15,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	State         string                 `protobuf:"bytes,16,opt,name=state,proto3" json:"state,omitempty"`
+	StateCode     string                 `protobuf:"bytes,17,opt,name=state_code,json=stateCode,proto3" json:"state_code,omitempty"`
+	Region        string                 `protobuf:"bytes,18,opt,name=region,proto3" json:"region,omitempty"`
+	Suburb        string                 `protobuf:"bytes,19,opt,name=suburb,proto3" json:"suburb,omitempty"`
+	Quarter       string                 `protobuf:"bytes,20,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	Residential   string                 `protobuf:"bytes,21,opt,name=residential,proto3" json:"residential,omitempty"`
+	Town          string                 `protobuf:"bytes,22,opt,name=town,proto3" json:"town,omitempty"`
+	Island        string                 `protobuf:"bytes,23,opt,name=island,proto3" json:"island,omitempty"`
+	Archipelago   string                 `protobuf:"bytes,24,opt,name=archipelago,proto3" json:"archipelago,omitempty"`
+	Country       string                 `protobuf:"bytes,25,opt,name=country,proto3" json:"country,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,26,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Continent     string                 `protobuf:"bytes,27,opt,name=continent,proto3" json:"continent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_addrfmt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Address) GetAttention() string {
+	if x != nil {
+		return x.Attention
+	}
+	return ""
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetHouseNumber() string {
+	if x != nil {
+		return x.HouseNumber
+	}
+	return ""
+}
+
+func (x *Address) GetRoad() string {
+	if x != nil {
+		return x.Road
+	}
+	return ""
+}
+
+func (x *Address) GetHamlet() string {
+	if x != nil {
+		return x.Hamlet
+	}
+	return ""
+}
+
+func (x *Address) GetVillage() string {
+	if x != nil {
+		return x.Village
+	}
+	return ""
+}
+
+func (x *Address) GetNeighbourhood() string {
+	if x != nil {
+		return x.Neighbourhood
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCity() string {
+	if x != nil {
+		return x.PostalCity
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetCityDistrict() string {
+	if x != nil {
+		return x.CityDistrict
+	}
+	return ""
+}
+
+func (x *Address) GetMunicipality() string {
+	if x != nil {
+		return x.Municipality
+	}
+	return ""
+}
+
+func (x *Address) GetCounty() string {
+	if x != nil {
+		return x.County
+	}
+	return ""
+}
+
+func (x *Address) GetCountyCode() string {
+	if x != nil {
+		return x.CountyCode
+	}
+	return ""
+}
+
+func (x *Address) GetStateDistrict() string {
+	if x != nil {
+		return x.StateDistrict
+	}
+	return ""
+}
+
+func (x *Address) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+func (x *Address) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Address) GetStateCode() string {
+	if x != nil {
+		return x.StateCode
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetSuburb() string {
+	if x != nil {
+		return x.Suburb
+	}
+	return ""
+}
+
+func (x *Address) GetQuarter() string {
+	if x != nil {
+		return x.Quarter
+	}
+	return ""
+}
+
+func (x *Address) GetResidential() string {
+	if x != nil {
+		return x.Residential
+	}
+	return ""
+}
+
+func (x *Address) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *Address) GetIsland() string {
+	if x != nil {
+		return x.Island
+	}
+	return ""
+}
+
+func (x *Address) GetArchipelago() string {
+	if x != nil {
+		return x.Archipelago
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *Address) GetContinent() string {
+	if x != nil {
+		return x.Continent
+	}
+	return ""
+}
+
+type FormatOptions struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	OutputFormat OutputFormat           `protobuf:"varint,1,opt,name=output_format,json=outputFormat,proto3,enum=addrfmt.v1.OutputFormat" json:"output_format,omitempty"`
+	// language of state names, country names and abbreviations, e.g. fr
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// abbreviate components such as Avenue => Ave
+	Abbreviate bool `protobuf:"varint,3,opt,name=abbreviate,proto3" json:"abbreviate,omitempty"`
+	// country code of the sender, the country line is omitted for addresses in this country
+	HomeCountry   string `protobuf:"bytes,4,opt,name=home_country,json=homeCountry,proto3" json:"home_country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatOptions) Reset() {
+	*x = FormatOptions{}
+	mi := &file_addrfmt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatOptions) ProtoMessage() {}
+
+func (x *FormatOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatOptions.ProtoReflect.Descriptor instead.
+func (*FormatOptions) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FormatOptions) GetOutputFormat() OutputFormat {
+	if x != nil {
+		return x.OutputFormat
+	}
+	return OutputFormat_OUTPUT_FORMAT_UNSPECIFIED
+}
+
+func (x *FormatOptions) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *FormatOptions) GetAbbreviate() bool {
+	if x != nil {
+		return x.Abbreviate
+	}
+	return false
+}
+
+func (x *FormatOptions) GetHomeCountry() string {
+	if x != nil {
+		return x.HomeCountry
+	}
+	return ""
+}
+
+type FormatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Options       *FormatOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatRequest) Reset() {
+	*x = FormatRequest{}
+	mi := &file_addrfmt_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatRequest) ProtoMessage() {}
+
+func (x *FormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatRequest.ProtoReflect.Descriptor instead.
+func (*FormatRequest) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FormatRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *FormatRequest) GetOptions() *FormatOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type FormatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// formatted address of the postal and one line output format
+	Formatted string `protobuf:"bytes,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	// lines of the array output format
+	Lines         []string `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatResponse) Reset() {
+	*x = FormatResponse{}
+	mi := &file_addrfmt_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatResponse) ProtoMessage() {}
+
+func (x *FormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatResponse.ProtoReflect.Descriptor instead.
+func (*FormatResponse) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FormatResponse) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+func (x *FormatResponse) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type FixRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// address components and their aliases, e.g. {"street": "Platz der Republik"}
+	Components map[string]string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// language of the state and country name
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FixRequest) Reset() {
+	*x = FixRequest{}
+	mi := &file_addrfmt_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixRequest) ProtoMessage() {}
+
+func (x *FixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixRequest.ProtoReflect.Descriptor instead.
+func (*FixRequest) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FixRequest) GetComponents() map[string]string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *FixRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type FixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FixResponse) Reset() {
+	*x = FixResponse{}
+	mi := &file_addrfmt_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixResponse) ProtoMessage() {}
+
+func (x *FixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixResponse.ProtoReflect.Descriptor instead.
+func (*FixResponse) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FixResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type FormatBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// position of the request in the stream
+	Index     int64    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Formatted string   `protobuf:"bytes,2,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	Lines     []string `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	// error of the address, the stream continues with the next address
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatBatchResponse) Reset() {
+	*x = FormatBatchResponse{}
+	mi := &file_addrfmt_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatBatchResponse) ProtoMessage() {}
+
+func (x *FormatBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatBatchResponse.ProtoReflect.Descriptor instead.
+func (*FormatBatchResponse) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FormatBatchResponse) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FormatBatchResponse) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+func (x *FormatBatchResponse) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *FormatBatchResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCountriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCountriesRequest) Reset() {
+	*x = ListCountriesRequest{}
+	mi := &file_addrfmt_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCountriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCountriesRequest) ProtoMessage() {}
+
+func (x *ListCountriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCountriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCountriesRequest) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{7}
+}
+
+type Country struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alpha2        string                 `protobuf:"bytes,1,opt,name=alpha2,proto3" json:"alpha2,omitempty"`
+	Alpha3        string                 `protobuf:"bytes,2,opt,name=alpha3,proto3" json:"alpha3,omitempty"`
+	Numeric       string                 `protobuf:"bytes,3,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	HasTemplate   bool                   `protobuf:"varint,5,opt,name=has_template,json=hasTemplate,proto3" json:"has_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Country) Reset() {
+	*x = Country{}
+	mi := &file_addrfmt_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Country) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Country) ProtoMessage() {}
+
+func (x *Country) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Country.ProtoReflect.Descriptor instead.
+func (*Country) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Country) GetAlpha2() string {
+	if x != nil {
+		return x.Alpha2
+	}
+	return ""
+}
+
+func (x *Country) GetAlpha3() string {
+	if x != nil {
+		return x.Alpha3
+	}
+	return ""
+}
+
+func (x *Country) GetNumeric() string {
+	if x != nil {
+		return x.Numeric
+	}
+	return ""
+}
+
+func (x *Country) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Country) GetHasTemplate() bool {
+	if x != nil {
+		return x.HasTemplate
+	}
+	return false
+}
+
+type ListCountriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []*Country             `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCountriesResponse) Reset() {
+	*x = ListCountriesResponse{}
+	mi := &file_addrfmt_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCountriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCountriesResponse) ProtoMessage() {}
+
+func (x *ListCountriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_addrfmt_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCountriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCountriesResponse) Descriptor() ([]byte, []int) {
+	return file_addrfmt_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCountriesResponse) GetCountries() []*Country {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+var File_addrfmt_proto protoreflect.FileDescriptor
+
+const file_addrfmt_proto_rawDesc = "" +
+	"\n" +
+	"\raddrfmt.proto\x12\n" +
+	"addrfmt.v1\"\x90\x06\n" +
+	"\aAddress\x12\x1c\n" +
+	"\tattention\x18\x01 \x01(\tR\tattention\x12\x14\n" +
+	"\x05house\x18\x02 \x01(\tR\x05house\x12!\n" +
+	"\fhouse_number\x18\x03 \x01(\tR\vhouseNumber\x12\x12\n" +
+	"\x04road\x18\x04 \x01(\tR\x04road\x12\x16\n" +
+	"\x06hamlet\x18\x05 \x01(\tR\x06hamlet\x12\x18\n" +
+	"\avillage\x18\x06 \x01(\tR\avillage\x12$\n" +
+	"\rneighbourhood\x18\a \x01(\tR\rneighbourhood\x12\x1f\n" +
+	"\vpostal_city\x18\b \x01(\tR\n" +
+	"postalCity\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12#\n" +
+	"\rcity_district\x18\n" +
+	" \x01(\tR\fcityDistrict\x12\"\n" +
+	"\fmunicipality\x18\v \x01(\tR\fmunicipality\x12\x16\n" +
+	"\x06county\x18\f \x01(\tR\x06county\x12\x1f\n" +
+	"\vcounty_code\x18\r \x01(\tR\n" +
+	"countyCode\x12%\n" +
+	"\x0estate_district\x18\x0e \x01(\tR\rstateDistrict\x12\x1a\n" +
+	"\bpostcode\x18\x0f \x01(\tR\bpostcode\x12\x14\n" +
+	"\x05state\x18\x10 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"state_code\x18\x11 \x01(\tR\tstateCode\x12\x16\n" +
+	"\x06region\x18\x12 \x01(\tR\x06region\x12\x16\n" +
+	"\x06suburb\x18\x13 \x01(\tR\x06suburb\x12\x18\n" +
+	"\aquarter\x18\x14 \x01(\tR\aquarter\x12 \n" +
+	"\vresidential\x18\x15 \x01(\tR\vresidential\x12\x12\n" +
+	"\x04town\x18\x16 \x01(\tR\x04town\x12\x16\n" +
+	"\x06island\x18\x17 \x01(\tR\x06island\x12 \n" +
+	"\varchipelago\x18\x18 \x01(\tR\varchipelago\x12\x18\n" +
+	"\acountry\x18\x19 \x01(\tR\acountry\x12!\n" +
+	"\fcountry_code\x18\x1a \x01(\tR\vcountryCode\x12\x1c\n" +
+	"\tcontinent\x18\x1b \x01(\tR\tcontinent\"\xad\x01\n" +
+	"\rFormatOptions\x12=\n" +
+	"\routput_format\x18\x01 \x01(\x0e2\x18.addrfmt.v1.OutputFormatR\foutputFormat\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1e\n" +
+	"\n" +
+	"abbreviate\x18\x03 \x01(\bR\n" +
+	"abbreviate\x12!\n" +
+	"\fhome_country\x18\x04 \x01(\tR\vhomeCountry\"s\n" +
+	"\rFormatRequest\x12-\n" +
+	"\aaddress\x18\x01 \x01(\v2\x13.addrfmt.v1.AddressR\aaddress\x123\n" +
+	"\aoptions\x18\x02 \x01(\v2\x19.addrfmt.v1.FormatOptionsR\aoptions\"D\n" +
+	"\x0eFormatResponse\x12\x1c\n" +
+	"\tformatted\x18\x01 \x01(\tR\tformatted\x12\x14\n" +
+	"\x05lines\x18\x02 \x03(\tR\x05lines\"\xaf\x01\n" +
+	"\n" +
+	"FixRequest\x12F\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2&.addrfmt.v1.FixRequest.ComponentsEntryR\n" +
+	"components\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x1a=\n" +
+	"\x0fComponentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
+	"\vFixResponse\x12-\n" +
+	"\aaddress\x18\x01 \x01(\v2\x13.addrfmt.v1.AddressR\aaddress\"u\n" +
+	"\x13FormatBatchResponse\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x1c\n" +
+	"\tformatted\x18\x02 \x01(\tR\tformatted\x12\x14\n" +
+	"\x05lines\x18\x03 \x03(\tR\x05lines\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x16\n" +
+	"\x14ListCountriesRequest\"\x8a\x01\n" +
+	"\aCountry\x12\x16\n" +
+	"\x06alpha2\x18\x01 \x01(\tR\x06alpha2\x12\x16\n" +
+	"\x06alpha3\x18\x02 \x01(\tR\x06alpha3\x12\x18\n" +
+	"\anumeric\x18\x03 \x01(\tR\anumeric\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fhas_template\x18\x05 \x01(\bR\vhasTemplate\"J\n" +
+	"\x15ListCountriesResponse\x121\n" +
+	"\tcountries\x18\x01 \x03(\v2\x13.addrfmt.v1.CountryR\tcountries*|\n" +
+	"\fOutputFormat\x12\x1d\n" +
+	"\x19OUTPUT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14OUTPUT_FORMAT_POSTAL\x10\x01\x12\x1a\n" +
+	"\x16OUTPUT_FORMAT_ONE_LINE\x10\x02\x12\x17\n" +
+	"\x13OUTPUT_FORMAT_ARRAY\x10\x032\xb0\x02\n" +
+	"\x10AddressFormatter\x12?\n" +
+	"\x06Format\x12\x19.addrfmt.v1.FormatRequest\x1a\x1a.addrfmt.v1.FormatResponse\x126\n" +
+	"\x03Fix\x12\x16.addrfmt.v1.FixRequest\x1a\x17.addrfmt.v1.FixResponse\x12M\n" +
+	"\vFormatBatch\x12\x19.addrfmt.v1.FormatRequest\x1a\x1f.addrfmt.v1.FormatBatchResponse(\x010\x01\x12T\n" +
+	"\rListCountries\x12 .addrfmt.v1.ListCountriesRequest\x1a!.addrfmt.v1.ListCountriesResponseB:Z8github.com/timonmasberg/address-formatter/grpc/addrfmtpbb\x06proto3"
+
+var (
+	file_addrfmt_proto_rawDescOnce sync.Once
+	file_addrfmt_proto_rawDescData []byte
+)
+
+func file_addrfmt_proto_rawDescGZIP() []byte {
+	file_addrfmt_proto_rawDescOnce.Do(func() {
+		file_addrfmt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_addrfmt_proto_rawDesc), len(file_addrfmt_proto_rawDesc)))
+	})
+	return file_addrfmt_proto_rawDescData
+}
+
+var file_addrfmt_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_addrfmt_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_addrfmt_proto_goTypes = []any{
+	(OutputFormat)(0),             // 0: addrfmt.v1.OutputFormat
+	(*Address)(nil),               // 1: addrfmt.v1.Address
+	(*FormatOptions)(nil),         // 2: addrfmt.v1.FormatOptions
+	(*FormatRequest)(nil),         // 3: addrfmt.v1.FormatRequest
+	(*FormatResponse)(nil),        // 4: addrfmt.v1.FormatResponse
+	(*FixRequest)(nil),            // 5: addrfmt.v1.FixRequest
+	(*FixResponse)(nil),           // 6: addrfmt.v1.FixResponse
+	(*FormatBatchResponse)(nil),   // 7: addrfmt.v1.FormatBatchResponse
+	(*ListCountriesRequest)(nil),  // 8: addrfmt.v1.ListCountriesRequest
+	(*Country)(nil),               // 9: addrfmt.v1.Country
+	(*ListCountriesResponse)(nil), // 10: addrfmt.v1.ListCountriesResponse
+	nil,                           // 11: addrfmt.v1.FixRequest.ComponentsEntry
+}
+var file_addrfmt_proto_depIdxs = []int32{
+	0,  // 0: addrfmt.v1.FormatOptions.output_format:type_name -> addrfmt.v1.OutputFormat
+	1,  // 1: addrfmt.v1.FormatRequest.address:type_name -> addrfmt.v1.Address
+	2,  // 2: addrfmt.v1.FormatRequest.options:type_name -> addrfmt.v1.FormatOptions
+	11, // 3: addrfmt.v1.FixRequest.components:type_name -> addrfmt.v1.FixRequest.ComponentsEntry
+	1,  // 4: addrfmt.v1.FixResponse.address:type_name -> addrfmt.v1.Address
+	9,  // 5: addrfmt.v1.ListCountriesResponse.countries:type_name -> addrfmt.v1.Country
+	3,  // 6: addrfmt.v1.AddressFormatter.Format:input_type -> addrfmt.v1.FormatRequest
+	5,  // 7: addrfmt.v1.AddressFormatter.Fix:input_type -> addrfmt.v1.FixRequest
+	3,  // 8: addrfmt.v1.AddressFormatter.FormatBatch:input_type -> addrfmt.v1.FormatRequest
+	8,  // 9: addrfmt.v1.AddressFormatter.ListCountries:input_type -> addrfmt.v1.ListCountriesRequest
+	4,  // 10: addrfmt.v1.AddressFormatter.Format:output_type -> addrfmt.v1.FormatResponse
+	6,  // 11: addrfmt.v1.AddressFormatter.Fix:output_type -> addrfmt.v1.FixResponse
+	7,  // 12: addrfmt.v1.AddressFormatter.FormatBatch:output_type -> addrfmt.v1.FormatBatchResponse
+	10, // 13: addrfmt.v1.AddressFormatter.ListCountries:output_type -> addrfmt.v1.ListCountriesResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_addrfmt_proto_init() }
+func file_addrfmt_proto_init() {
+	if File_addrfmt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_addrfmt_proto_rawDesc), len(file_addrfmt_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_addrfmt_proto_goTypes,
+		DependencyIndexes: file_addrfmt_proto_depIdxs,
+		EnumInfos:         file_addrfmt_proto_enumTypes,
+		MessageInfos:      file_addrfmt_proto_msgTypes,
+	}.Build()
+	File_addrfmt_proto = out.File
+	file_addrfmt_proto_goTypes = nil
+	file_addrfmt_proto_depIdxs = nil
+}
@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: addrfmt.proto
+
+package addrfmtpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AddressFormatter_Format_FullMethodName        = "/addrfmt.v1.AddressFormatter/Format"
+	AddressFormatter_Fix_FullMethodName           = "/addrfmt.v1.AddressFormatter/Fix"
+	AddressFormatter_FormatBatch_FullMethodName   = "/addrfmt.v1.AddressFormatter/FormatBatch"
+	AddressFormatter_ListCountries_FullMethodName = "/addrfmt.v1.AddressFormatter/ListCountries"
+)
+
+// AddressFormatterClient is the client API for AddressFormatter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AddressFormatter formats and fixes addresses with the OpenCage address formatting templates.
+type AddressFormatterClient interface {
+	// Format formats an address.
+	Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error)
+	// Fix determines the country code, state code, county code and country of the components and maps them to an address.
+	Fix(ctx context.Context, in *FixRequest, opts ...grpc.CallOption) (*FixResponse, error)
+	// FormatBatch formats a stream of addresses, the responses have the order of the requests.
+	FormatBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[FormatRequest, FormatBatchResponse], error)
+	// ListCountries lists the ISO 3166-1 countries and whether the configuration has a template for them.
+	ListCountries(ctx context.Context, in *ListCountriesRequest, opts ...grpc.CallOption) (*ListCountriesResponse, error)
+}
+
+type addressFormatterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAddressFormatterClient(cc grpc.ClientConnInterface) AddressFormatterClient {
+	return &addressFormatterClient{cc}
+}
+
+func (c *addressFormatterClient) Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FormatResponse)
+	err := c.cc.Invoke(ctx, AddressFormatter_Format_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressFormatterClient) Fix(ctx context.Context, in *FixRequest, opts ...grpc.CallOption) (*FixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FixResponse)
+	err := c.cc.Invoke(ctx, AddressFormatter_Fix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressFormatterClient) FormatBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[FormatRequest, FormatBatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AddressFormatter_ServiceDesc.Streams[0], AddressFormatter_FormatBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FormatRequest, FormatBatchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AddressFormatter_FormatBatchClient = grpc.BidiStreamingClient[FormatRequest, FormatBatchResponse]
+
+func (c *addressFormatterClient) ListCountries(ctx context.Context, in *ListCountriesRequest, opts ...grpc.CallOption) (*ListCountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCountriesResponse)
+	err := c.cc.Invoke(ctx, AddressFormatter_ListCountries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddressFormatterServer is the server API for AddressFormatter service.
+// All implementations must embed UnimplementedAddressFormatterServer
+// for forward compatibility.
+//
+// AddressFormatter formats and fixes addresses with the OpenCage address formatting templates.
+type AddressFormatterServer interface {
+	// Format formats an address.
+	Format(context.Context, *FormatRequest) (*FormatResponse, error)
+	// Fix determines the country code, state code, county code and country of the components and maps them to an address.
+	Fix(context.Context, *FixRequest) (*FixResponse, error)
+	// FormatBatch formats a stream of addresses, the responses have the order of the requests.
+	FormatBatch(grpc.BidiStreamingServer[FormatRequest, FormatBatchResponse]) error
+	// ListCountries lists the ISO 3166-1 countries and whether the configuration has a template for them.
+	ListCountries(context.Context, *ListCountriesRequest) (*ListCountriesResponse, error)
+	mustEmbedUnimplementedAddressFormatterServer()
+}
+
+// UnimplementedAddressFormatterServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAddressFormatterServer struct{}
+
+func (UnimplementedAddressFormatterServer) Format(context.Context, *FormatRequest) (*FormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Format not implemented")
+}
+func (UnimplementedAddressFormatterServer) Fix(context.Context, *FixRequest) (*FixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fix not implemented")
+}
+func (UnimplementedAddressFormatterServer) FormatBatch(grpc.BidiStreamingServer[FormatRequest, FormatBatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FormatBatch not implemented")
+}
+func (UnimplementedAddressFormatterServer) ListCountries(context.Context, *ListCountriesRequest) (*ListCountriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCountries not implemented")
+}
+func (UnimplementedAddressFormatterServer) mustEmbedUnimplementedAddressFormatterServer() {}
+func (UnimplementedAddressFormatterServer) testEmbeddedByValue()                          {}
+
+// UnsafeAddressFormatterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AddressFormatterServer will
+// result in compilation errors.
+type UnsafeAddressFormatterServer interface {
+	mustEmbedUnimplementedAddressFormatterServer()
+}
+
+func RegisterAddressFormatterServer(s grpc.ServiceRegistrar, srv AddressFormatterServer) {
+	// If the following call pancis, it indicates UnimplementedAddressFormatterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AddressFormatter_ServiceDesc, srv)
+}
+
+func _AddressFormatter_Format_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressFormatterServer).Format(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressFormatter_Format_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressFormatterServer).Format(ctx, req.(*FormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressFormatter_Fix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressFormatterServer).Fix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressFormatter_Fix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressFormatterServer).Fix(ctx, req.(*FixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressFormatter_FormatBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AddressFormatterServer).FormatBatch(&grpc.GenericServerStream[FormatRequest, FormatBatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AddressFormatter_FormatBatchServer = grpc.BidiStreamingServer[FormatRequest, FormatBatchResponse]
+
+func _AddressFormatter_ListCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCountriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressFormatterServer).ListCountries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressFormatter_ListCountries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressFormatterServer).ListCountries(ctx, req.(*ListCountriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AddressFormatter_ServiceDesc is the grpc.ServiceDesc for AddressFormatter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AddressFormatter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "addrfmt.v1.AddressFormatter",
+	HandlerType: (*AddressFormatterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Format",
+			Handler:    _AddressFormatter_Format_Handler,
+		},
+		{
+			MethodName: "Fix",
+			Handler:    _AddressFormatter_Fix_Handler,
+		},
+		{
+			MethodName: "ListCountries",
+			Handler:    _AddressFormatter_ListCountries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FormatBatch",
+			Handler:       _AddressFormatter_FormatBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "addrfmt.proto",
+}
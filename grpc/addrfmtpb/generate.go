@@ -0,0 +1,4 @@
+// Package addrfmtpb contains the messages and the service generated from addrfmt.proto.
+package addrfmtpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative addrfmt.proto
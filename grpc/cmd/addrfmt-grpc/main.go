@@ -0,0 +1,83 @@
+// Command addrfmt-grpc serves the addrfmt.v1.AddressFormatter gRPC service with server reflection and the standard
+// health service.
+package main
+
+import (
+	"context"
+	"flag"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	addrfmtgrpc "github.com/timonmasberg/address-formatter/grpc"
+	"github.com/timonmasberg/address-formatter/grpc/addrfmtpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"log"
+	"net"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "address to listen on")
+	configDir := flag.String("config", "templates", "directory of the OpenCage configuration (worldwide.yaml in countries/)")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 15*time.Second, "maximum duration to finish running calls on shutdown")
+	unknownAsAttention := flag.Bool("unknown-as-attention", false, "treat unknown components as attention")
+	resolveCountryNames := flag.Bool("resolve-country-names", false, "determine missing country codes from the country name")
+	flag.Parse()
+
+	config := addrFmt.LoadConfig(addrFmt.ConfigFiles{
+		CountriesPath:     filepath.Join(*configDir, "countries", "worldwide.yaml"),
+		ComponentsPath:    filepath.Join(*configDir, "components.yaml"),
+		StateCodesPath:    filepath.Join(*configDir, "state_codes.yaml"),
+		CountryToLangPath: filepath.Join(*configDir, "country2lang.yaml"),
+		CountyCodesPath:   filepath.Join(*configDir, "county_codes.yaml"),
+		CountryCodesPath:  filepath.Join(*configDir, "country_codes.yaml"),
+		AbbreviationFiles: filepath.Join(*configDir, "abbreviations", "*.yaml"),
+	})
+	config.UnknownAsAttention = *unknownAsAttention
+	config.ResolveCountryNames = *resolveCountryNames
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("Could not listen on %s: %v", *addr, err)
+	}
+
+	healthServer := health.NewServer()
+	grpcServer := grpc.NewServer()
+	addrfmtpb.RegisterAddressFormatterServer(grpcServer, addrfmtgrpc.NewServer(config))
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		log.Printf("Listening on %s", listener.Addr())
+		serverErr <- grpcServer.Serve(listener)
+	}()
+
+	select {
+	case err = <-serverErr:
+		log.Fatalf("Server failed: %v", err)
+	case <-ctx.Done():
+	}
+
+	log.Printf("Shutting down")
+	healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(*shutdownTimeout):
+		grpcServer.Stop()
+	}
+}
@@ -0,0 +1,23 @@
+module github.com/timonmasberg/address-formatter/grpc
+
+go 1.24.0
+
+require (
+	github.com/stretchr/testify v1.7.0
+	github.com/timonmasberg/address-formatter v0.0.0
+	google.golang.org/grpc v1.79.3
+	google.golang.org/protobuf v1.36.10
+)
+
+require (
+	github.com/cbroglie/mustache v1.4.0 // indirect
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.48.0 // indirect
+	golang.org/x/sys v0.39.0 // indirect
+	golang.org/x/text v0.32.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251202230838-ff82c1b0f217 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/timonmasberg/address-formatter => ../
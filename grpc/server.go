@@ -0,0 +1,174 @@
+// Package addrfmtgrpc implements the addrfmt.v1.AddressFormatter gRPC service defined in addrfmtpb/addrfmt.proto.
+//
+// The service lives in its own module so that the library does not depend on gRPC.
+package addrfmtgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/grpc/addrfmtpb"
+	"github.com/timonmasberg/address-formatter/iso3166"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+var outputFormats = map[addrfmtpb.OutputFormat]addrFmt.OutputFormat{
+	addrfmtpb.OutputFormat_OUTPUT_FORMAT_UNSPECIFIED: addrFmt.PostalFormat,
+	addrfmtpb.OutputFormat_OUTPUT_FORMAT_POSTAL:      addrFmt.PostalFormat,
+	addrfmtpb.OutputFormat_OUTPUT_FORMAT_ONE_LINE:    addrFmt.OneLine,
+	addrfmtpb.OutputFormat_OUTPUT_FORMAT_ARRAY:       addrFmt.Array,
+}
+
+// Server formats and fixes addresses with a shared config, which must not be changed while the server is running
+type Server struct {
+	addrfmtpb.UnimplementedAddressFormatterServer
+	config *addrFmt.Config
+}
+
+// NewServer creates the service, register it with addrfmtpb.RegisterAddressFormatterServer
+func NewServer(config *addrFmt.Config) *Server {
+	return &Server{config: config}
+}
+
+func (s *Server) Format(ctx context.Context, req *addrfmtpb.FormatRequest) (*addrfmtpb.FormatResponse, error) {
+	formatted, lines, err := s.format(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &addrfmtpb.FormatResponse{Formatted: formatted, Lines: lines}, nil
+}
+
+func (s *Server) Fix(ctx context.Context, req *addrfmtpb.FixRequest) (*addrfmtpb.FixResponse, error) {
+	if req.GetComponents() == nil {
+		return nil, errInvalidArgument("components are missing")
+	}
+
+	var opts []addrFmt.Option
+	if req.GetLanguage() != "" {
+		opts = append(opts, addrFmt.WithLanguage(req.GetLanguage()))
+	}
+
+	address, err := addrFmt.GetFixedAddressContext(ctx, req.GetComponents(), s.config, opts...)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &addrfmtpb.FixResponse{Address: toProtoAddress(address)}, nil
+}
+
+// FormatBatch formats the addresses in the order they are received, errors of single addresses are sent in their
+// response and the stream continues with the next address
+func (s *Server) FormatBatch(stream addrfmtpb.AddressFormatter_FormatBatchServer) error {
+	ctx := stream.Context()
+
+	for index := int64(0); ; index++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		formatted, lines, err := s.format(ctx, req)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+
+		res := &addrfmtpb.FormatBatchResponse{Index: index, Formatted: formatted, Lines: lines}
+		if err != nil {
+			res.Error = status.Convert(err).Message()
+		}
+
+		if err = stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) ListCountries(_ context.Context, _ *addrfmtpb.ListCountriesRequest) (*addrfmtpb.ListCountriesResponse, error) {
+	countries := iso3166.Countries()
+	res := &addrfmtpb.ListCountriesResponse{Countries: make([]*addrfmtpb.Country, len(countries))}
+
+	for i, country := range countries {
+		_, hasTemplate := s.config.Template(country.Alpha2)
+		res.Countries[i] = &addrfmtpb.Country{
+			Alpha2:      country.Alpha2,
+			Alpha3:      country.Alpha3,
+			Numeric:     country.Numeric,
+			Name:        country.Name,
+			HasTemplate: hasTemplate,
+		}
+	}
+
+	return res, nil
+}
+
+// format returns the formatted address of the postal and one line output format or the lines of the array output format
+func (s *Server) format(ctx context.Context, req *addrfmtpb.FormatRequest) (string, []string, error) {
+	if req.GetAddress() == nil {
+		return "", nil, errInvalidArgument("address is missing")
+	}
+
+	config, opts, err := s.requestConfig(req.GetOptions())
+	if err != nil {
+		return "", nil, err
+	}
+
+	formatted, err := addrFmt.FormatAddressContext(ctx, fromProtoAddress(req.GetAddress()), config, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch formatted := formatted.(type) {
+	case []string:
+		return "", formatted, nil
+	case string:
+		return formatted, nil, nil
+	default:
+		return "", nil, fmt.Errorf("unexpected formatted address of type %T", formatted)
+	}
+}
+
+// requestConfig applies the options of the request to a copy of the config
+func (s *Server) requestConfig(formatOptions *addrfmtpb.FormatOptions) (*addrFmt.Config, []addrFmt.Option, error) {
+	outputFormat, isKnown := outputFormats[formatOptions.GetOutputFormat()]
+	if !isKnown {
+		return nil, nil, errInvalidArgument(fmt.Sprintf("unknown output format %d", formatOptions.GetOutputFormat()))
+	}
+
+	config := *s.config
+	config.OutputFormat = outputFormat
+	config.Abbreviate = config.Abbreviate || formatOptions.GetAbbreviate()
+
+	var opts []addrFmt.Option
+	if formatOptions.GetLanguage() != "" {
+		opts = append(opts, addrFmt.WithLanguage(formatOptions.GetLanguage()))
+	}
+	if formatOptions.GetHomeCountry() != "" {
+		opts = append(opts, addrFmt.WithHomeCountry(formatOptions.GetHomeCountry()))
+	}
+
+	return &config, opts, nil
+}
+
+func errInvalidArgument(message string) error {
+	return status.Error(codes.InvalidArgument, message)
+}
+
+// toStatusError keeps status errors, maps context errors to Canceled and DeadlineExceeded and all other errors,
+// which are caused by the address, to InvalidArgument
+func toStatusError(err error) error {
+	if _, isStatus := status.FromError(err); isStatus {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
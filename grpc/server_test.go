@@ -0,0 +1,191 @@
+package addrfmtgrpc
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	addrFmt "github.com/timonmasberg/address-formatter"
+	"github.com/timonmasberg/address-formatter/grpc/addrfmtpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
+	"testing"
+)
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+// ServerTestSuite calls the service through an in-process listener
+type ServerTestSuite struct {
+	suite.Suite
+	GRPCServer *grpc.Server
+	Conn       *grpc.ClientConn
+	Client     addrfmtpb.AddressFormatterClient
+}
+
+func (suite *ServerTestSuite) SetupTest() {
+	config := addrFmt.LoadConfig(addrFmt.ConfigFiles{
+		CountriesPath:     "../testdata/conf/countries/worldwide.yaml",
+		ComponentsPath:    "../testdata/conf/components.yaml",
+		StateCodesPath:    "../testdata/conf/state_codes.yaml",
+		CountryToLangPath: "../testdata/conf/country2lang.yaml",
+		CountyCodesPath:   "../testdata/conf/county_codes.yaml",
+		CountryCodesPath:  "../testdata/conf/country_codes.yaml",
+		AbbreviationFiles: "../testdata/conf/abbreviations/*.yaml",
+	})
+
+	listener := bufconn.Listen(1 << 20)
+	suite.GRPCServer = grpc.NewServer()
+	addrfmtpb.RegisterAddressFormatterServer(suite.GRPCServer, NewServer(config))
+	reflection.Register(suite.GRPCServer)
+	go func() {
+		_ = suite.GRPCServer.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	suite.Require().NoError(err)
+	suite.Conn = conn
+	suite.Client = addrfmtpb.NewAddressFormatterClient(conn)
+}
+
+func (suite *ServerTestSuite) TearDownTest() {
+	suite.Conn.Close()
+	suite.GRPCServer.Stop()
+}
+
+func bundestag() *addrfmtpb.Address {
+	return &addrfmtpb.Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", CountryCode: "DE"}
+}
+
+func (suite *ServerTestSuite) TestFormat() {
+	res, err := suite.Client.Format(context.Background(), &addrfmtpb.FormatRequest{
+		Address: bundestag(),
+		Options: &addrfmtpb.FormatOptions{OutputFormat: addrfmtpb.OutputFormat_OUTPUT_FORMAT_ONE_LINE},
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal("Platz der Republik 1, 11011 Berlin", res.GetFormatted())
+	suite.Empty(res.GetLines())
+}
+
+func (suite *ServerTestSuite) TestFormatArray() {
+	res, err := suite.Client.Format(context.Background(), &addrfmtpb.FormatRequest{
+		Address: bundestag(),
+		Options: &addrfmtpb.FormatOptions{OutputFormat: addrfmtpb.OutputFormat_OUTPUT_FORMAT_ARRAY},
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal([]string{"Platz der Republik 1", "11011 Berlin"}, res.GetLines())
+	suite.Empty(res.GetFormatted())
+}
+
+func (suite *ServerTestSuite) TestFormatInvalidArgument() {
+	_, err := suite.Client.Format(context.Background(), &addrfmtpb.FormatRequest{})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.Client.Format(context.Background(), &addrfmtpb.FormatRequest{
+		Address: bundestag(),
+		Options: &addrfmtpb.FormatOptions{OutputFormat: 42},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestFormatCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := suite.Client.Format(ctx, &addrfmtpb.FormatRequest{Address: bundestag()})
+	suite.Equal(codes.Canceled, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestFix() {
+	res, err := suite.Client.Fix(context.Background(), &addrfmtpb.FixRequest{
+		Components: map[string]string{"street": "Platz der Republik", "house_number": "1", "postcode": "11011", "city": "Berlin", "country_code": "deu"},
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal("Platz der Republik", res.GetAddress().GetRoad())
+	suite.Equal("DE", res.GetAddress().GetCountryCode())
+
+	_, err = suite.Client.Fix(context.Background(), &addrfmtpb.FixRequest{})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ServerTestSuite) TestFormatBatch() {
+	stream, err := suite.Client.FormatBatch(context.Background())
+	suite.Require().NoError(err)
+
+	oneLine := &addrfmtpb.FormatOptions{OutputFormat: addrfmtpb.OutputFormat_OUTPUT_FORMAT_ONE_LINE}
+	suite.Require().NoError(stream.Send(&addrfmtpb.FormatRequest{Address: bundestag(), Options: oneLine}))
+	suite.Require().NoError(stream.Send(&addrfmtpb.FormatRequest{Options: oneLine}))
+	suite.Require().NoError(stream.Send(&addrfmtpb.FormatRequest{Address: bundestag(), Options: oneLine}))
+	suite.Require().NoError(stream.CloseSend())
+
+	var responses []*addrfmtpb.FormatBatchResponse
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		suite.Require().NoError(err)
+		responses = append(responses, res)
+	}
+
+	suite.Require().Len(responses, 3)
+	for i, res := range responses {
+		suite.Equal(int64(i), res.GetIndex())
+	}
+	suite.Equal("Platz der Republik 1, 11011 Berlin", responses[0].GetFormatted())
+	suite.Equal("address is missing", responses[1].GetError())
+	suite.Equal("Platz der Republik 1, 11011 Berlin", responses[2].GetFormatted())
+}
+
+func (suite *ServerTestSuite) TestListCountries() {
+	res, err := suite.Client.ListCountries(context.Background(), &addrfmtpb.ListCountriesRequest{})
+	suite.Require().NoError(err)
+
+	countries := make(map[string]*addrfmtpb.Country, len(res.GetCountries()))
+	for _, country := range res.GetCountries() {
+		countries[country.GetAlpha2()] = country
+	}
+
+	suite.Equal("DEU", countries["DE"].GetAlpha3())
+	suite.Equal("276", countries["DE"].GetNumeric())
+	suite.True(countries["DE"].GetHasTemplate())
+	suite.False(countries["AQ"].GetHasTemplate())
+}
+
+func (suite *ServerTestSuite) TestReflection() {
+	stream, err := reflectionpb.NewServerReflectionClient(suite.Conn).ServerReflectionInfo(context.Background())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	res, err := stream.Recv()
+	suite.Require().NoError(err)
+
+	var services []string
+	for _, service := range res.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	suite.Contains(services, "addrfmt.v1.AddressFormatter")
+
+	suite.Require().NoError(stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "addrfmt.v1.AddressFormatter"},
+	}))
+	res, err = stream.Recv()
+	suite.Require().NoError(err)
+	suite.NotEmpty(res.GetFileDescriptorResponse().GetFileDescriptorProto())
+}
@@ -0,0 +1,117 @@
+// Package testcase reads the testcase files of OpenCageData's address-formatting repository.
+//
+// A file holds one or more YAML documents of the form
+//
+//	description: Bundestag
+//	components:
+//	    road: Platz der Republik
+//	    house_number: 1
+//	    country_code: de
+//	expected: |
+//	    Platz der Republik 1
+//	    Deutschland
+package testcase
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type Case struct {
+	// File is the path of the testcase file
+	File string
+	// Index is the position of the case in its file, starting at 1
+	Index       int
+	Description string
+	// Country is the upper case name of the file such as DE for de.yaml
+	Country    string
+	Components map[string]string
+	Expected   string
+}
+
+// Name identifies the case by its file and its description or index
+func (c Case) Name() string {
+	if c.Description != "" {
+		return fmt.Sprintf("%s: %s", filepath.Base(c.File), c.Description)
+	}
+
+	return fmt.Sprintf("%s #%d", filepath.Base(c.File), c.Index)
+}
+
+type document struct {
+	Description string            `yaml:"description,omitempty"`
+	Components  map[string]string `yaml:"components"`
+	Expected    string            `yaml:"expected"`
+}
+
+// LoadDir reads the cases of all .yaml files in dir and its subdirectories, ordered by their path
+func LoadDir(dir string) ([]Case, error) {
+	var paths []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.EqualFold(filepath.Ext(path), ".yaml") {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var cases []Case
+	for _, path := range paths {
+		fileCases, err := LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, fileCases...)
+	}
+
+	return cases, nil
+}
+
+// LoadFile reads the cases of a file, documents without components are skipped
+func LoadFile(path string) ([]Case, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	fileName := filepath.Base(path)
+	country := strings.ToUpper(strings.TrimSuffix(fileName, filepath.Ext(fileName)))
+
+	decoder := yaml.NewDecoder(file)
+	var cases []Case
+	for index := 1; ; index++ {
+		var doc document
+		if err = decoder.Decode(&doc); errors.Is(err, io.EOF) {
+			return cases, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %w", path, index, err)
+		}
+
+		if doc.Components == nil {
+			continue
+		}
+
+		cases = append(cases, Case{
+			File:        path,
+			Index:       index,
+			Description: doc.Description,
+			Country:     country,
+			Components:  doc.Components,
+			Expected:    doc.Expected,
+		})
+	}
+}
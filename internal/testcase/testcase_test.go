@@ -0,0 +1,96 @@
+package testcase
+
+import (
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTestCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(TestCaseTestSuite))
+}
+
+type TestCaseTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TestCaseTestSuite) TestLoadDir() {
+	cases, err := LoadDir("../../testdata/testcases")
+	suite.Require().NoError(err)
+	suite.Require().Len(cases, 4)
+
+	suite.Equal("DE", cases[0].Country)
+	suite.Equal(1, cases[0].Index)
+	suite.Equal("de.yaml: Bundestag", cases[0].Name())
+	suite.Equal("1", cases[0].Components["house_number"])
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\nDeutschland\n", cases[0].Expected)
+	suite.Equal(2, cases[1].Index)
+	suite.Equal("US", cases[2].Country)
+	suite.Equal("NO_COUNTRY", cases[3].Country)
+}
+
+func (suite *TestCaseTestSuite) TestLoadFileReportsParseErrors() {
+	path := filepath.Join(suite.T().TempDir(), "de.yaml")
+	suite.Require().NoError(os.WriteFile(path, []byte("---\ncomponents:\n    city: Berlin\nexpected: Berlin\n---\ncomponents: [\n"), 0o600))
+
+	_, err := LoadFile(path)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "document 2")
+}
+
+func (suite *TestCaseTestSuite) TestNameWithoutDescription() {
+	suite.Equal("de.yaml #3", Case{File: "testcases/de.yaml", Index: 3}.Name())
+}
+
+func (suite *TestCaseTestSuite) TestWriteFile() {
+	cases := []Case{
+		{Description: "Bundestag", Components: map[string]string{"road": "Platz der Republik", "postcode": "11011"}, Expected: "Platz der Republik\n11011\n"},
+		{Components: map[string]string{"city": "Berlin"}, Expected: "Berlin\n"},
+	}
+	path := filepath.Join(suite.T().TempDir(), "de.yaml")
+	suite.Require().NoError(WriteFile(path, cases))
+
+	content, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Equal(`---
+description: Bundestag
+components:
+    postcode: "11011"
+    road: Platz der Republik
+expected: |
+    Platz der Republik
+    11011
+---
+components:
+    city: Berlin
+expected: |
+    Berlin
+`, string(content))
+
+	loadedCases, err := LoadFile(path)
+	suite.Require().NoError(err)
+	suite.Require().Len(loadedCases, 2)
+	suite.Equal(cases[0].Components, loadedCases[0].Components)
+	suite.Equal(cases[1].Expected, loadedCases[1].Expected)
+}
+
+func (suite *TestCaseTestSuite) TestUpdateFile() {
+	path := filepath.Join(suite.T().TempDir(), "de.yaml")
+	suite.Require().NoError(os.WriteFile(path, []byte("---\n# Berlin\ncomponents:\n    city: Berlin\n---\ncomponents:\n    city: Bonn\nexpected: Bonn\n"), 0o600))
+
+	suite.Require().NoError(UpdateFile(path, map[int]string{1: "Berlin\n", 2: "Bonn\n"}))
+
+	content, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Equal("---\n# Berlin\ncomponents:\n    city: Berlin\nexpected: |\n    Berlin\n---\ncomponents:\n    city: Bonn\nexpected: |\n    Bonn\n", string(content))
+
+	suite.Require().NoError(os.WriteFile(path, []byte("---\n- Berlin\n"), 0o600))
+	suite.Error(UpdateFile(path, map[int]string{1: "Berlin\n"}))
+}
+
+func (suite *TestCaseTestSuite) TestDiff() {
+	suite.Empty(Diff("Berlin\n", "Berlin\n"))
+	suite.Equal("--- expected\n+++ actual\n@@ -1,2 +1,2 @@\n Platz der Republik 1\n-11011 Berlin\n+D-11011 Berlin\n",
+		Diff("Platz der Republik 1\n11011 Berlin\n", "Platz der Republik 1\nD-11011 Berlin"))
+}
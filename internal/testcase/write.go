@@ -0,0 +1,140 @@
+package testcase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"strings"
+)
+
+// Write writes the cases as YAML documents in the format LoadFile reads
+func Write(w io.Writer, cases []Case) error {
+	for _, c := range cases {
+		var buffer bytes.Buffer
+		buffer.WriteString("---\n")
+
+		encoder := yaml.NewEncoder(&buffer)
+		encoder.SetIndent(4)
+		err := encoder.Encode(document{Description: c.Description, Components: c.Components, Expected: c.Expected})
+		if err == nil {
+			err = encoder.Close()
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = w.Write(buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteFile replaces the file with the cases
+func WriteFile(path string, cases []Case) error {
+	var buffer bytes.Buffer
+	if err := Write(&buffer, cases); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buffer.Bytes(), 0o644)
+}
+
+// UpdateFile replaces the expected output of the documents with the given index (see Case.Index). Only the expected
+// values are changed, comments, other keys and documents without components are kept
+func UpdateFile(path string, expected map[int]string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for index := 1; ; index++ {
+		var doc yaml.Node
+		if err = decoder.Decode(&doc); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("%s: document %d: %w", path, index, err)
+		}
+
+		if value, hasValue := expected[index]; hasValue {
+			if err = setExpected(&doc, value); err != nil {
+				return fmt.Errorf("%s: document %d: %w", path, index, err)
+			}
+		}
+
+		buffer.WriteString("---\n")
+		encoder := yaml.NewEncoder(&buffer)
+		encoder.SetIndent(4)
+		if err = encoder.Encode(&doc); err == nil {
+			err = encoder.Close()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(path, buffer.Bytes(), 0o644)
+}
+
+// setExpected replaces the value of the expected key of the document or adds the key
+func setExpected(doc *yaml.Node, expected string) error {
+	if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return errors.New("document is not a mapping")
+	}
+
+	style := yaml.Style(0)
+	if strings.Contains(expected, "\n") {
+		style = yaml.LiteralStyle
+	}
+
+	mapping := doc.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == "expected" {
+			value := mapping.Content[i+1]
+			value.Kind, value.Tag, value.Value, value.Style, value.Content = yaml.ScalarNode, "!!str", expected, style, nil
+			return nil
+		}
+	}
+
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "expected"},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: expected, Style: style},
+	)
+
+	return nil
+}
+
+// Diff returns the unified diff of the expected and the actual output, which is empty if they are equal
+func Diff(expected string, actual string) string {
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(expected),
+		B:        splitLines(actual),
+		FromFile: "expected",
+		ToFile:   "actual",
+		Context:  3,
+	})
+
+	return diff
+}
+
+// splitLines splits the text after each line break, a missing line break at the end is added
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+
+	return lines
+}
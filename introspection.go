@@ -0,0 +1,162 @@
+package addrFmt
+
+import (
+	"github.com/timonmasberg/address-formatter/iso3166"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// CountryTemplate describes the template of a country in worldwide.yaml. Values of malformed templates, which
+// Validate reports, are left empty
+type CountryTemplate struct {
+	CountryCode string
+	// UsesCountry is the country whose template is used instead, e.g. ES for IC
+	UsesCountry string
+	// ChangeCountry replaces the country component, $component is replaced with the value of the component
+	ChangeCountry string
+	// AddComponent adds a component such as state=Canarias
+	AddComponent string
+	// AddressTemplate and FallbackTemplate are empty if the country uses the ones of the default template
+	AddressTemplate        string
+	FallbackTemplate       string
+	Replacements           []Replacement
+	PostformatReplacements []Replacement
+}
+
+// Replacement is a rule of replace or postformat_replace
+type Replacement struct {
+	Pattern     string
+	Replacement string
+	// Component is set for replace rules such as state=United States Virgin Islands, which replace the whole value of
+	// the component if it equals the rest of the pattern
+	Component string
+}
+
+var componentRuleRegExp = regexp.MustCompile(`^(\w+)=`)
+
+// Countries returns the codes of the countries with a template in ascending order
+func (config *Config) Countries() []string {
+	var countryCodes []string
+	seen := make(map[string]bool)
+
+	for _, templates := range []map[string]template{config.Templates, config.templateOverlay} {
+		for name := range templates {
+			if len(name) == 2 && strings.ToUpper(name) == name && !seen[name] {
+				seen[name] = true
+				countryCodes = append(countryCodes, name)
+			}
+		}
+	}
+	sort.Strings(countryCodes)
+
+	return countryCodes
+}
+
+// Template returns the template of the country, false if the country is formatted with the default template.
+// Alpha-3 and numeric codes are converted, other codes such as UK are looked up as they are
+func (config *Config) Template(countryCode string) (*CountryTemplate, bool) {
+	countryCode = strings.ToUpper(strings.TrimSpace(countryCode))
+	if alpha2, isIsoCode := iso3166.ToAlpha2(countryCode); isIsoCode {
+		countryCode = alpha2
+	}
+
+	template, hasTemplate := config.getTemplates().get(countryCode)
+	if !hasTemplate || countryCode == "" {
+		return nil, false
+	}
+
+	templateValue, _ := template.(map[string]interface{})
+	countryTemplate := &CountryTemplate{
+		CountryCode:            countryCode,
+		ChangeCountry:          getTemplateString(templateValue, "change_country"),
+		AddComponent:           getTemplateString(templateValue, "add_component"),
+		AddressTemplate:        getTemplateString(templateValue, "address_template"),
+		FallbackTemplate:       getTemplateString(templateValue, "fallback_template"),
+		Replacements:           getReplacements(templateValue["replace"], true),
+		PostformatReplacements: getReplacements(templateValue["postformat_replace"], false),
+	}
+	if usesCountry := getTemplateString(templateValue, "use_country"); usesCountry != "" {
+		countryTemplate.UsesCountry = strings.ToUpper(usesCountry)
+	}
+	if templateText, isText := template.(string); isText {
+		countryTemplate.AddressTemplate = templateText
+	}
+
+	return countryTemplate, true
+}
+
+// UsesCountry returns the country whose template is used for the country, e.g. ES for IC
+func (config *Config) UsesCountry(countryCode string) (string, bool) {
+	countryTemplate, hasTemplate := config.Template(countryCode)
+	if !hasTemplate || countryTemplate.UsesCountry == "" {
+		return "", false
+	}
+
+	return countryTemplate.UsesCountry, true
+}
+
+// ChangeCountry returns the country component the country sets, e.g. España for IC
+func (config *Config) ChangeCountry(countryCode string) (string, bool) {
+	countryTemplate, hasTemplate := config.Template(countryCode)
+	if !hasTemplate || countryTemplate.ChangeCountry == "" {
+		return "", false
+	}
+
+	return countryTemplate.ChangeCountry, true
+}
+
+// AddComponent returns the component the country adds, e.g. state=Canarias for IC
+func (config *Config) AddComponent(countryCode string) (string, bool) {
+	countryTemplate, hasTemplate := config.Template(countryCode)
+	if !hasTemplate || countryTemplate.AddComponent == "" {
+		return "", false
+	}
+
+	return countryTemplate.AddComponent, true
+}
+
+// Replacements returns the replace rules GetFixedAddress applies to the components of the country
+func (config *Config) Replacements(countryCode string) []Replacement {
+	if countryTemplate, hasTemplate := config.Template(countryCode); hasTemplate {
+		return countryTemplate.Replacements
+	}
+
+	return nil
+}
+
+// PostformatReplacements returns the postformat_replace rules FormatAddress applies to the rendered address
+func (config *Config) PostformatReplacements(countryCode string) []Replacement {
+	if countryTemplate, hasTemplate := config.Template(countryCode); hasTemplate {
+		return countryTemplate.PostformatReplacements
+	}
+
+	return nil
+}
+
+func getTemplateString(templateValue map[string]interface{}, key string) string {
+	value, _ := templateValue[key].(string)
+
+	return value
+}
+
+// getReplacements converts the rules, component rules are only detected for replace rules
+func getReplacements(rules interface{}, hasComponentRules bool) []Replacement {
+	ruleList, _ := rules.([]interface{})
+
+	var replacements []Replacement
+	for _, rule := range ruleList {
+		pattern, replace, err := getReplacementRule(rule)
+		if err != nil {
+			continue
+		}
+
+		replacement := Replacement{Pattern: pattern, Replacement: replace}
+		if matches := componentRuleRegExp.FindStringSubmatch(pattern); hasComponentRules && matches != nil {
+			replacement.Component = matches[1]
+		}
+		replacements = append(replacements, replacement)
+	}
+
+	return replacements
+}
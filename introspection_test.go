@@ -0,0 +1,95 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestIntrospectionTestSuite(t *testing.T) {
+	suite.Run(t, new(IntrospectionTestSuite))
+}
+
+type IntrospectionTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *IntrospectionTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+}
+
+func (suite *IntrospectionTestSuite) TestCountries() {
+	suite.Equal([]string{"CH", "CN", "DE", "ES", "GB", "IC", "JP", "KR", "UK", "US"}, suite.Config.Countries())
+
+	registry := NewTenantRegistry(suite.Config)
+	suite.Require().NoError(registry.Register("acme", map[string]interface{}{"AT": map[string]interface{}{"use_country": "DE"}}))
+	suite.Contains(registry.Config("acme").Countries(), "AT")
+	suite.NotContains(suite.Config.Countries(), "AT")
+}
+
+func (suite *IntrospectionTestSuite) TestTemplate() {
+	template, hasTemplate := suite.Config.Template("ic")
+	suite.True(hasTemplate)
+	suite.Equal(&CountryTemplate{CountryCode: "IC", UsesCountry: "ES", ChangeCountry: "España", AddComponent: "state=Canarias"}, template)
+
+	// the template explains the fixed address
+	address, err := GetFixedAddress(map[string]string{"road": "Calle de León y Castillo", "country_code": "IC"}, suite.Config)
+	suite.NoError(err)
+	suite.Equal("ES", address.CountryCode)
+	suite.Equal("España", address.Country)
+	suite.Equal("Canarias", address.State)
+
+	template, _ = suite.Config.Template("USA")
+	suite.Equal("US", template.CountryCode)
+	suite.Contains(template.FallbackTemplate, "{{{state_code}}}")
+
+	_, hasTemplate = suite.Config.Template("FR")
+	suite.False(hasTemplate)
+	_, hasTemplate = suite.Config.Template("default")
+	suite.False(hasTemplate)
+}
+
+func (suite *IntrospectionTestSuite) TestTemplateFeatures() {
+	usesCountry, hasUseCountry := suite.Config.UsesCountry("UK")
+	suite.True(hasUseCountry)
+	suite.Equal("GB", usesCountry)
+	_, hasUseCountry = suite.Config.UsesCountry("DE")
+	suite.False(hasUseCountry)
+
+	changeCountry, hasChangeCountry := suite.Config.ChangeCountry("IC")
+	suite.True(hasChangeCountry)
+	suite.Equal("España", changeCountry)
+	_, hasChangeCountry = suite.Config.ChangeCountry("FR")
+	suite.False(hasChangeCountry)
+
+	addComponent, hasAddComponent := suite.Config.AddComponent("IC")
+	suite.True(hasAddComponent)
+	suite.Equal("state=Canarias", addComponent)
+}
+
+func (suite *IntrospectionTestSuite) TestReplacements() {
+	suite.Equal([]Replacement{
+		{Pattern: "state=United States Virgin Islands", Replacement: "US Virgin Islands", Component: "state"},
+	}, suite.Config.Replacements("US"))
+	suite.Equal([]Replacement{
+		{Pattern: "\nUS$", Replacement: "\nUnited States of America"},
+		{Pattern: "\nUSA$", Replacement: "\nUnited States of America"},
+	}, suite.Config.PostformatReplacements("US"))
+
+	suite.Equal([]Replacement{{Pattern: "^Stadtteil ", Replacement: ""}, {Pattern: "^Landkreis ", Replacement: ""}}, suite.Config.Replacements("DE"))
+	suite.Empty(suite.Config.Replacements("ES"))
+	suite.Empty(suite.Config.PostformatReplacements("FR"))
+}
+
+func (suite *IntrospectionTestSuite) TestMalformedTemplate() {
+	config, err := ParseConfig(ConfigData{Countries: []byte("DE:\n  use_country: 1\n  replace: [[\"a\", \"b\"], [1, 2]]\nAT: \"{{{road}}}\"\n")})
+	suite.Require().NoError(err)
+
+	template, hasTemplate := config.Template("DE")
+	suite.True(hasTemplate)
+	suite.Empty(template.UsesCountry)
+	suite.Equal([]Replacement{{Pattern: "a", Replacement: "b"}}, template.Replacements)
+
+	template, _ = config.Template("AT")
+	suite.Equal("{{{road}}}", template.AddressTemplate)
+}
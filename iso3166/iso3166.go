@@ -0,0 +1,113 @@
+// Package iso3166 converts between ISO 3166-1 alpha-2, alpha-3 and numeric country codes and parses ISO 3166-2 subdivision codes
+package iso3166
+
+import (
+	"regexp"
+	"strings"
+)
+
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string
+	Name    string
+}
+
+var alpha2Index = make(map[string]int, len(countries))
+var alpha3Index = make(map[string]int, len(countries))
+var numericIndex = make(map[string]int, len(countries))
+
+func init() {
+	for i, country := range countries {
+		alpha2Index[country.Alpha2] = i
+		alpha3Index[country.Alpha3] = i
+		numericIndex[country.Numeric] = i
+	}
+}
+
+// Countries returns all ISO 3166-1 countries ordered by their alpha-2 code
+func Countries() []Country {
+	result := make([]Country, len(countries))
+	copy(result, countries)
+
+	return result
+}
+
+// Lookup finds a country by its alpha-2, alpha-3 or numeric code, letter codes are case-insensitive
+func Lookup(code string) (Country, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	var i int
+	var isKnown bool
+
+	switch {
+	case isNumeric(code):
+		i, isKnown = numericIndex[padNumeric(code)]
+	case len(code) == 2:
+		i, isKnown = alpha2Index[code]
+	case len(code) == 3:
+		i, isKnown = alpha3Index[code]
+	}
+
+	if !isKnown {
+		return Country{}, false
+	}
+
+	return countries[i], true
+}
+
+// ToAlpha2 converts any ISO 3166-1 code into its alpha-2 code
+func ToAlpha2(code string) (string, bool) {
+	country, isKnown := Lookup(code)
+
+	return country.Alpha2, isKnown
+}
+
+// ToAlpha3 converts any ISO 3166-1 code into its alpha-3 code
+func ToAlpha3(code string) (string, bool) {
+	country, isKnown := Lookup(code)
+
+	return country.Alpha3, isKnown
+}
+
+// ToNumeric converts any ISO 3166-1 code into its three digit numeric code
+func ToNumeric(code string) (string, bool) {
+	country, isKnown := Lookup(code)
+
+	return country.Numeric, isKnown
+}
+
+var subdivisionCheck = regexp.MustCompile(`^([A-Z]{2})-([A-Z0-9]{1,3})$`)
+
+// ParseSubdivision splits an ISO 3166-2 subdivision code such as "US-CA" or "DE-BY" into its country and subdivision part
+func ParseSubdivision(code string) (countryCode string, subdivisionCode string, ok bool) {
+	matches := subdivisionCheck.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(code)))
+
+	if matches == nil {
+		return "", "", false
+	}
+
+	if _, isCountry := alpha2Index[matches[1]]; !isCountry {
+		return "", "", false
+	}
+
+	return matches[1], matches[2], true
+}
+
+func isNumeric(code string) bool {
+	if code == "" || len(code) > 3 {
+		return false
+	}
+
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func padNumeric(code string) string {
+	return strings.Repeat("0", 3-len(code)) + code
+}
@@ -0,0 +1,82 @@
+package iso3166
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestISO3166TestSuite(t *testing.T) {
+	suite.Run(t, new(ISO3166TestSuite))
+}
+
+type ISO3166TestSuite struct {
+	suite.Suite
+}
+
+func (suite *ISO3166TestSuite) TestLookup() {
+	germany := Country{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", Name: "Germany"}
+
+	for _, code := range []string{"DE", "de", "DEU", "deu", "276", " DE "} {
+		country, isKnown := Lookup(code)
+
+		suite.True(isKnown, "%s should be known", code)
+		suite.Equal(germany, country)
+	}
+
+	for _, code := range []string{"", "UK", "XK", "ABCD", "999", "1234"} {
+		_, isKnown := Lookup(code)
+
+		suite.False(isKnown, "%s should not be known", code)
+	}
+}
+
+func (suite *ISO3166TestSuite) TestConversions() {
+	alpha2, isKnown := ToAlpha2("USA")
+	suite.True(isKnown)
+	suite.Equal("US", alpha2)
+
+	alpha3, isKnown := ToAlpha3("840")
+	suite.True(isKnown)
+	suite.Equal("USA", alpha3)
+
+	numeric, isKnown := ToNumeric("at")
+	suite.True(isKnown)
+	suite.Equal("040", numeric)
+
+	numeric, isKnown = ToNumeric("40")
+	suite.True(isKnown)
+	suite.Equal("040", numeric, "Numeric codes should be padded")
+}
+
+func (suite *ISO3166TestSuite) TestParseSubdivision() {
+	subdivisions := map[string][2]string{
+		"US-CA":  {"US", "CA"},
+		"de-by":  {"DE", "BY"},
+		"FR-75C": {"FR", "75C"},
+		"GB-ENG": {"GB", "ENG"},
+	}
+
+	for code, expected := range subdivisions {
+		countryCode, subdivisionCode, isSubdivision := ParseSubdivision(code)
+
+		suite.True(isSubdivision, "%s should be a subdivision", code)
+		suite.Equal(expected[0], countryCode)
+		suite.Equal(expected[1], subdivisionCode)
+	}
+
+	for _, code := range []string{"", "CA", "US-", "XX-CA", "US-CALI", "USA-CA"} {
+		_, _, isSubdivision := ParseSubdivision(code)
+
+		suite.False(isSubdivision, "%s should not be a subdivision", code)
+	}
+}
+
+func (suite *ISO3166TestSuite) TestCountries() {
+	countries := Countries()
+
+	suite.Len(countries, 249)
+	suite.Equal("AD", countries[0].Alpha2)
+
+	countries[0].Alpha2 = "XX"
+	suite.Equal("AD", Countries()[0].Alpha2, "Countries should return a copy")
+}
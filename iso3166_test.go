@@ -0,0 +1,77 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestISOCodesTestSuite(t *testing.T) {
+	suite.Run(t, new(ISOCodesTestSuite))
+}
+
+type ISOCodesTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *ISOCodesTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = PostalFormat
+}
+
+func (suite *ISOCodesTestSuite) TestGetFixedAddressCountryCodes() {
+	for _, countryCode := range []string{"US", "us", "USA", "840", "uk"} {
+		address, err := GetFixedAddress(addressMap{"road": "Main Street", "country_code": countryCode}, suite.Config)
+
+		suite.NoError(err)
+		if countryCode == "uk" {
+			suite.Equal("GB", address.CountryCode)
+		} else {
+			suite.Equal("US", address.CountryCode, "%s should be converted", countryCode)
+		}
+	}
+}
+
+func (suite *ISOCodesTestSuite) TestGetFixedAddressSubdivisionCode() {
+	address, err := GetFixedAddress(addressMap{"state_code": "US-CA", "city": "Los Angeles"}, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("US", address.CountryCode)
+	suite.Equal("CA", address.StateCode)
+
+	address, err = GetFixedAddress(addressMap{"state_code": "DE-BY", "country_code": "at"}, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("AT", address.CountryCode, "Provided country code should be kept")
+	suite.Equal("BY", address.StateCode)
+}
+
+func (suite *ISOCodesTestSuite) TestFormatAddressCountryCodes() {
+	expected := "1600 Pennsylvania Avenue NW\nWashington, DC 20500\nUnited States of America\n"
+
+	for _, countryCode := range []string{"US", "us", "USA", "840"} {
+		formattedAddress, err := FormatAddress(&Address{
+			HouseNumber: "1600",
+			Road:        "Pennsylvania Avenue NW",
+			City:        "Washington",
+			StateCode:   "DC",
+			Postcode:    "20500",
+			Country:     "United States of America",
+			CountryCode: countryCode,
+		}, suite.Config)
+
+		suite.NoError(err)
+		suite.Equal(expected, formattedAddress, "%s should use the US template", countryCode)
+	}
+
+	formattedAddress, err := FormatAddress(&Address{
+		HouseNumber: "1",
+		Road:        "Main Street",
+		City:        "Sacramento",
+		StateCode:   "US-CA",
+		Postcode:    "95814",
+	}, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("1 Main Street\nSacramento, CA 95814\n", formattedAddress)
+}
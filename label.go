@@ -0,0 +1,149 @@
+package addrFmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// LabelSpec limits the size of an address label such as carrier labels, zero means unlimited
+type LabelSpec struct {
+	MaxLineLen int
+	MaxLines   int
+}
+
+var ErrLabelTooSmall = errors.New("address does not fit on the label")
+
+// components dropped one after another until an address fits on a label
+var labelDropOrder = []string{
+	"county",
+	"state_district",
+	"region",
+	"island",
+	"archipelago",
+	"city_district",
+	"suburb",
+	"neighbourhood",
+	"quarter",
+	"residential",
+	"municipality",
+	"hamlet",
+}
+
+// FormatLabel formats an Address into lines that fit on a label. If the rendered address is too large, components
+// are abbreviated first, then long lines are wrapped at component boundaries and at last low priority components
+// are dropped in the order of labelDropOrder. ErrLabelTooSmall is returned if the address still does not fit
+func FormatLabel(address *Address, config *Config, spec LabelSpec, opts ...Option) ([]string, error) {
+	addressMap, err := addressToMap(address)
+	if err != nil {
+		return nil, err
+	}
+
+	options := newOptions(opts)
+	unlimitedLineLen := LabelSpec{MaxLines: spec.MaxLines}
+
+	lines, err := renderLabel(addressMap, config, options, unlimitedLineLen)
+	if err != nil || spec.fits(lines) {
+		return lines, err
+	}
+
+	// with Abbreviate the components are already abbreviated when they are rendered
+	if !config.Abbreviate {
+		applyAbbreviations(addressMap, config, options.language)
+		lines, err = renderLabel(addressMap, config, options, unlimitedLineLen)
+		if err != nil || spec.fits(lines) {
+			return lines, err
+		}
+	}
+
+	lines, err = renderLabel(addressMap, config, options, spec)
+	if err != nil || spec.fits(lines) {
+		return lines, err
+	}
+
+	for _, component := range labelDropOrder {
+		if _, hasComponent := addressMap[component]; !hasComponent {
+			continue
+		}
+
+		delete(addressMap, component)
+		lines, err = renderLabel(addressMap, config, options, spec)
+		if err != nil || spec.fits(lines) {
+			return lines, err
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %d lines with up to %d characters required", ErrLabelTooSmall, len(lines), maxLineLength(lines))
+}
+
+func renderLabel(addressMap addressMap, config *Config, options *options, spec LabelSpec) ([]string, error) {
+	render, err := renderAddressMap(context.Background(), copyAddressMap(addressMap), config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0)
+	for _, line := range strings.Split(render, "\n") {
+		lines = append(lines, wrapLine(line, spec.MaxLineLen)...)
+	}
+
+	return lines, nil
+}
+
+func (spec LabelSpec) fits(lines []string) bool {
+	if spec.MaxLines > 0 && len(lines) > spec.MaxLines {
+		return false
+	}
+
+	return spec.MaxLineLen <= 0 || maxLineLength(lines) <= spec.MaxLineLen
+}
+
+// wrapLine breaks a line at the commas between components first and at spaces second
+func wrapLine(line string, maxLineLen int) []string {
+	if maxLineLen <= 0 || utf8.RuneCountInString(line) <= maxLineLen {
+		return []string{line}
+	}
+
+	lines := make([]string, 0)
+	for _, part := range packWords(strings.Split(line, ", "), ", ", maxLineLen) {
+		if utf8.RuneCountInString(part) <= maxLineLen {
+			lines = append(lines, part)
+		} else {
+			lines = append(lines, packWords(strings.Split(part, " "), " ", maxLineLen)...)
+		}
+	}
+
+	return lines
+}
+
+// packWords joins as many words into a line as fit, a word longer than maxLineLen gets its own line
+func packWords(words []string, glue string, maxLineLen int) []string {
+	lines := make([]string, 0)
+	line := ""
+
+	for _, word := range words {
+		if line == "" {
+			line = word
+		} else if utf8.RuneCountInString(line+glue+word) <= maxLineLen {
+			line += glue + word
+		} else {
+			lines = append(lines, line)
+			line = word
+		}
+	}
+
+	return append(lines, line)
+}
+
+func maxLineLength(lines []string) int {
+	maxLength := 0
+	for _, line := range lines {
+		if length := utf8.RuneCountInString(line); length > maxLength {
+			maxLength = length
+		}
+	}
+
+	return maxLength
+}
@@ -0,0 +1,103 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestLabelTestSuite(t *testing.T) {
+	suite.Run(t, new(LabelTestSuite))
+}
+
+type LabelTestSuite struct {
+	suite.Suite
+	Config  *Config
+	Address *Address
+}
+
+func (suite *LabelTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+
+	suite.Address = &Address{
+		HouseNumber: "1600",
+		Road:        "Pennsylvania Avenue Northwest",
+		City:        "Washington",
+		StateCode:   "DC",
+		Postcode:    "20500",
+		Country:     "United States of America",
+		CountryCode: "US",
+	}
+}
+
+func (suite *LabelTestSuite) TestFits() {
+	lines, err := FormatLabel(suite.Address, suite.Config, LabelSpec{MaxLineLen: 40, MaxLines: 4})
+
+	suite.NoError(err)
+	suite.Equal([]string{"1600 Pennsylvania Avenue Northwest", "Washington, DC 20500", "United States of America"}, lines)
+
+	lines, err = FormatLabel(suite.Address, suite.Config, LabelSpec{})
+
+	suite.NoError(err)
+	suite.Len(lines, 3, "Zero values should not limit the label")
+}
+
+func (suite *LabelTestSuite) TestAbbreviates() {
+	lines, err := FormatLabel(suite.Address, suite.Config, LabelSpec{MaxLineLen: 31, MaxLines: 4})
+
+	suite.NoError(err)
+	suite.Equal([]string{"1600 Pennsylvania Ave Northwest", "Washington, DC 20500", "United States of America"}, lines)
+}
+
+func (suite *LabelTestSuite) TestWraps() {
+	lines, err := FormatLabel(suite.Address, suite.Config, LabelSpec{MaxLineLen: 25, MaxLines: 5})
+
+	suite.NoError(err)
+	suite.Equal([]string{"1600 Pennsylvania Ave", "Northwest", "Washington, DC 20500", "United States of America"}, lines)
+
+	lines, err = FormatLabel(suite.Address, suite.Config, LabelSpec{MaxLineLen: 12, MaxLines: 9})
+
+	suite.NoError(err)
+	suite.Equal([]string{"1600", "Pennsylvania", "Ave", "Northwest", "Washington", "DC 20500", "United", "States of", "America"}, lines)
+}
+
+func (suite *LabelTestSuite) TestDropsComponents() {
+	address := &Address{
+		City:        "Springfield",
+		County:      "Sangamon County",
+		State:       "Illinois",
+		Country:     "United States of America",
+		CountryCode: "US",
+		Continent:   "North America",
+	}
+
+	lines, err := FormatLabel(address, suite.Config, LabelSpec{MaxLineLen: 30, MaxLines: 3})
+
+	suite.NoError(err)
+	suite.Equal([]string{"Springfield", "Illinois", "United States of America"}, lines)
+}
+
+func (suite *LabelTestSuite) TestTooSmall() {
+	_, err := FormatLabel(suite.Address, suite.Config, LabelSpec{MaxLineLen: 10, MaxLines: 4})
+
+	suite.ErrorIs(err, ErrLabelTooSmall)
+}
+
+func (suite *LabelTestSuite) TestAbbreviateConfig() {
+	suite.Config.Abbreviate = true
+	suite.Config.OutputFormat = OneLine
+
+	formattedAddress, err := FormatAddress(suite.Address, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("1600 Pennsylvania Ave Northwest, Washington, DC 20500, United States of America", formattedAddress)
+
+	formattedAddress, err = FormatAddress(&Address{Road: "Platz der Republik", HouseNumber: "1", CountryCode: "DE"}, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("Pl der Republik 1", formattedAddress)
+
+	lines, err := FormatLabel(suite.Address, suite.Config, LabelSpec{MaxLineLen: 25, MaxLines: 5})
+
+	suite.NoError(err)
+	suite.Equal([]string{"1600 Pennsylvania Ave", "Northwest", "Washington, DC 20500", "United States of America"}, lines)
+}
@@ -0,0 +1,66 @@
+package addrFmt
+
+import (
+	"strings"
+)
+
+// WithLanguage writes state names, the country name and abbreviations in the language of the letter
+// instead of the main language of the country, e.g. "fr" for an address in Bern (CH) => "Berne"
+func WithLanguage(language string) Option {
+	return func(o *options) {
+		o.language = strings.ToLower(strings.TrimSpace(language))
+	}
+}
+
+// localizeComponents replaces state and country with their translation into language if there is one
+func localizeComponents(addressMap addressMap, config *Config, language string) {
+	if language == "" {
+		return
+	}
+
+	countryCode := addressMap["country_code"]
+	if state, hasState := addressMap["state"]; hasState {
+		addressMap["state"] = getLocalizedState(state, addressMap["state_code"], countryCode, language, config.StateCodes)
+	}
+	if country, hasCountry := addressMap["country"]; hasCountry {
+		addressMap["country"] = getLocalizedCountry(country, countryCode, language)
+	}
+}
+
+// getLocalizedState looks up the language variant of the state in state_codes.yaml (e.g. fr: Berne),
+// the state code is determined by the state name if it is missing
+func getLocalizedState(state string, stateCode string, countryCode string, language string, stateCodes map[string]map[string]interface{}) string {
+	if stateCode == "" {
+		stateCode = getStateCode(state, countryCode, stateCodes)
+	}
+
+	variants, hasVariants := stateCodes[countryCode][strings.ToUpper(stateCode)].(map[string]interface{})
+	if !hasVariants {
+		return state
+	}
+
+	for _, key := range []string{language, "alt_" + language} {
+		if variant, hasVariant := variants[key].(string); hasVariant {
+			return variant
+		}
+	}
+
+	return state
+}
+
+func getLocalizedCountry(country string, countryCode string, language string) string {
+	if name, hasTranslation := countryNames[countryCode][language]; hasTranslation {
+		return name
+	}
+
+	return country
+}
+
+// getOutputLanguages returns the language of the letter if there is one or the languages spoken in the country
+func getOutputLanguages(countryCode string, config *Config, language string) []string {
+	if language != "" {
+		return []string{language}
+	}
+
+	return getCountryLanguages(countryCode, config)
+}
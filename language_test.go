@@ -0,0 +1,96 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestLanguageTestSuite(t *testing.T) {
+	suite.Run(t, new(LanguageTestSuite))
+}
+
+type LanguageTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *LanguageTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = OneLine
+}
+
+func (suite *LanguageTestSuite) TestGetLocalizedState() {
+	stateCodes := suite.Config.StateCodes
+
+	suite.Equal("Berne", getLocalizedState("Bern", "BE", "CH", "fr", stateCodes))
+	suite.Equal("Ginevra", getLocalizedState("Genf", "", "CH", "it", stateCodes))
+	suite.Equal("Genf", getLocalizedState("Genf", "", "CH", "rm", stateCodes))
+	suite.Equal("Bavaria", getLocalizedState("Bayern", "BY", "DE", "en", stateCodes))
+	suite.Equal("Berlin", getLocalizedState("Berlin", "BE", "DE", "fr", stateCodes))
+}
+
+func (suite *LanguageTestSuite) TestFormatAddressWithLanguage() {
+	address := &Address{
+		Road:        "Avenue de France",
+		HouseNumber: "12",
+		Postcode:    "1202",
+		City:        "Genève",
+		State:       "Genf",
+		Country:     "Schweiz",
+		CountryCode: "CH",
+	}
+	suite.Config.Abbreviate = true
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithLanguage("fr"))
+
+	suite.NoError(err)
+	suite.Equal("Av de France 12, 1202 Genève, Suisse", formattedAddress)
+}
+
+func (suite *LanguageTestSuite) TestAbbreviationsFollowLanguage() {
+	address := &Address{
+		Road:        "Avenue de France",
+		HouseNumber: "12",
+		Postcode:    "1202",
+		City:        "Genève",
+		CountryCode: "CH",
+	}
+	suite.Config.Abbreviate = true
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithLanguage("en"))
+
+	suite.NoError(err)
+	suite.Equal("Ave de France 12, 1202 Genève", formattedAddress)
+}
+
+func (suite *LanguageTestSuite) TestGetFixedAddressWithLanguage() {
+	address, err := GetFixedAddress(map[string]string{
+		"road":         "Bundesplatz",
+		"house_number": "3",
+		"city":         "Bern",
+		"state":        "Bern",
+		"country":      "Schweiz",
+		"country_code": "ch",
+	}, suite.Config, WithLanguage("fr"))
+
+	suite.NoError(err)
+	suite.Equal("Berne", address.State)
+	suite.Equal("BE", address.StateCode)
+	suite.Equal("Bern", address.City)
+	suite.Equal("Suisse", address.Country)
+}
+
+func (suite *LanguageTestSuite) TestFormatForMailingWithLanguage() {
+	address := &Address{
+		Road:        "Platz der Republik",
+		HouseNumber: "1",
+		Postcode:    "11011",
+		City:        "Berlin",
+		CountryCode: "DE",
+	}
+
+	formattedAddress, err := FormatForMailing(address, "CH", suite.Config, WithLanguage("it"))
+
+	suite.NoError(err)
+	suite.Equal("Platz der Republik 1, 11011 Berlin, Germania", formattedAddress)
+}
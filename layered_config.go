@@ -0,0 +1,204 @@
+package addrFmt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MergeMode decides how the replace and postformat_replace rules of a layer are merged
+type MergeMode int
+
+const (
+	// MergeAppend appends the rules of the layer to the rules of the previous layers
+	MergeAppend MergeMode = iota
+	// MergeReplace replaces the rules of the previous layers
+	MergeReplace MergeMode = iota
+)
+
+// ConfigLayer is a set of configuration files merged on top of the previous layers. Files with an empty path are
+// skipped, except in the first layer which needs all files like ReadConfig
+type ConfigLayer struct {
+	// Name identifies the layer in the origins of the config values
+	Name         string
+	Files        ConfigFiles
+	Replacements MergeMode
+}
+
+// ReadLayeredConfig reads the layers in order and merges each one into the previous ones:
+//   - keys of a country template override the keys of the previous template, replace and postformat_replace rules
+//     are appended or replaced according to the MergeMode of the layer. A template that is a text replaces the previous one
+//   - component aliases are added, new aliases of a component rank after its previous aliases
+//   - state codes, county codes, country codes, country languages and abbreviations are merged by their keys
+func ReadLayeredConfig(layers []ConfigLayer) (*Config, error) {
+	if len(layers) == 0 {
+		return nil, errors.New("no config layers")
+	}
+
+	config := &Config{
+		ComponentAliases: make(map[string]componentAlias),
+		Templates:        make(map[string]template),
+		StateCodes:       make(map[string]map[string]interface{}),
+		CountryToLang:    make(map[string]interface{}),
+		CountyCodes:      make(map[string]map[string]interface{}),
+		CountryCodes:     make(map[string]string),
+		Abbreviations:    make(map[string]abbreviation),
+		origins:          make(map[string]string),
+		cache:            newConfigCache(),
+	}
+
+	for i, layer := range layers {
+		layerConfig, err := readConfigFiles(layer.Files, i > 0)
+		if err != nil {
+			return nil, fmt.Errorf("layer %s: %w", layer.Name, err)
+		}
+
+		config.mergeTemplates(layerConfig.Templates, layer)
+		config.mergeComponentAliases(layerConfig.ComponentAliases, layer.Name)
+		config.mergeCodes("state_codes", config.StateCodes, layerConfig.StateCodes, layer.Name)
+		config.mergeCodes("county_codes", config.CountyCodes, layerConfig.CountyCodes, layer.Name)
+		config.mergeAbbreviations(layerConfig.Abbreviations, layer.Name)
+
+		for countryCode, languages := range layerConfig.CountryToLang {
+			config.CountryToLang[countryCode] = languages
+			config.setOrigin(layer.Name, "country_to_lang", countryCode)
+		}
+		for countryCode, countryName := range layerConfig.CountryCodes {
+			config.CountryCodes[countryCode] = countryName
+			config.setOrigin(layer.Name, "country_codes", countryCode)
+		}
+	}
+
+	return config, nil
+}
+
+// Origin returns the name of the layer the value at path comes from. Paths are
+//
+//	templates/<name>                          a template text such as generic1
+//	templates/<country code>/<key>            e.g. address_template or use_country
+//	templates/<country code>/<key>/<index>    a replace or postformat_replace rule
+//	component_aliases/<alias>
+//	state_codes/<country code>/<state code>
+//	county_codes/<country code>/<county code>
+//	country_to_lang/<country code>
+//	country_codes/<country code>
+//	abbreviations/<language>/<component>/<word>
+//
+// Only configs of ReadLayeredConfig have origins
+func (config *Config) Origin(path ...string) (string, bool) {
+	layer, hasOrigin := config.origins[strings.Join(path, "/")]
+
+	return layer, hasOrigin
+}
+
+func (config *Config) setOrigin(layer string, path ...string) {
+	config.origins[strings.Join(path, "/")] = layer
+}
+
+// removeOrigins removes the origins of the value at path and of all values below it
+func (config *Config) removeOrigins(path ...string) {
+	prefix := strings.Join(path, "/")
+
+	for key := range config.origins {
+		if key == prefix || strings.HasPrefix(key, prefix+"/") {
+			delete(config.origins, key)
+		}
+	}
+}
+
+func (config *Config) mergeTemplates(layerTemplates map[string]template, layer ConfigLayer) {
+	for name, layerTemplate := range layerTemplates {
+		layerTemplateMap, isLayerTemplateMap := layerTemplate.(map[string]interface{})
+		templateMap, isTemplateMap := config.Templates[name].(map[string]interface{})
+
+		if !isLayerTemplateMap {
+			config.Templates[name] = layerTemplate
+			config.removeOrigins("templates", name)
+			config.setOrigin(layer.Name, "templates", name)
+			continue
+		}
+
+		if !isTemplateMap {
+			templateMap = make(map[string]interface{}, len(layerTemplateMap))
+			config.Templates[name] = templateMap
+			config.removeOrigins("templates", name)
+		}
+
+		for key, value := range layerTemplateMap {
+			rules, isRules := value.([]interface{})
+			if (key != "replace" && key != "postformat_replace") || !isRules {
+				templateMap[key] = value
+				config.removeOrigins("templates", name, key)
+				config.setOrigin(layer.Name, "templates", name, key)
+				continue
+			}
+
+			previousRules, _ := templateMap[key].([]interface{})
+			if layer.Replacements == MergeReplace {
+				previousRules = nil
+				config.removeOrigins("templates", name, key)
+			}
+
+			mergedRules := make([]interface{}, 0, len(previousRules)+len(rules))
+			mergedRules = append(append(mergedRules, previousRules...), rules...)
+			templateMap[key] = mergedRules
+
+			for i := len(previousRules); i < len(mergedRules); i++ {
+				config.setOrigin(layer.Name, "templates", name, key, strconv.Itoa(i))
+			}
+		}
+	}
+}
+
+func (config *Config) mergeComponentAliases(layerAliases map[string]componentAlias, layer string) {
+	// new aliases rank after the aliases of the previous layers
+	nextRanks := make(map[string]int)
+	for _, alias := range config.ComponentAliases {
+		if alias.aliasOrderRank >= nextRanks[alias.componentName] {
+			nextRanks[alias.componentName] = alias.aliasOrderRank + 1
+		}
+	}
+
+	for aliasName, alias := range layerAliases {
+		if previousAlias, hasAlias := config.ComponentAliases[aliasName]; hasAlias && previousAlias.componentName == alias.componentName {
+			continue
+		}
+
+		alias.aliasOrderRank += nextRanks[alias.componentName]
+		config.ComponentAliases[aliasName] = alias
+		config.setOrigin(layer, "component_aliases", aliasName)
+	}
+}
+
+func (config *Config) mergeCodes(section string, codes map[string]map[string]interface{}, layerCodes map[string]map[string]interface{}, layer string) {
+	for countryCode, layerCountryCodes := range layerCodes {
+		if codes[countryCode] == nil {
+			codes[countryCode] = make(map[string]interface{}, len(layerCountryCodes))
+		}
+
+		for code, value := range layerCountryCodes {
+			codes[countryCode][code] = value
+			config.setOrigin(layer, section, countryCode, code)
+		}
+	}
+}
+
+func (config *Config) mergeAbbreviations(layerAbbreviations map[string]abbreviation, layer string) {
+	for language, layerAbbreviation := range layerAbbreviations {
+		if config.Abbreviations[language] == nil {
+			config.Abbreviations[language] = make(abbreviation, len(layerAbbreviation))
+		}
+
+		for component, words := range layerAbbreviation {
+			if config.Abbreviations[language][component] == nil {
+				config.Abbreviations[language][component] = make(map[string]string, len(words))
+			}
+
+			for word, short := range words {
+				config.Abbreviations[language][component][word] = short
+				config.setOrigin(layer, "abbreviations", language, component, word)
+			}
+		}
+	}
+}
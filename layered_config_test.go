@@ -0,0 +1,148 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"os"
+	"testing"
+)
+
+func TestLayeredConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(LayeredConfigTestSuite))
+}
+
+type LayeredConfigTestSuite struct {
+	suite.Suite
+	Layers []ConfigLayer
+}
+
+func (suite *LayeredConfigTestSuite) SetupTest() {
+	suite.Layers = []ConfigLayer{
+		{
+			Name:  "opencage",
+			Files: testConfigFiles,
+		},
+		{
+			Name: "local",
+			Files: ConfigFiles{
+				CountriesPath:     "testdata/overlay/countries.yaml",
+				ComponentsPath:    "testdata/overlay/components.yaml",
+				StateCodesPath:    "testdata/overlay/state_codes.yaml",
+				AbbreviationFiles: "testdata/overlay/abbreviations/*.yaml",
+			},
+		},
+	}
+}
+
+func (suite *LayeredConfigTestSuite) read() *Config {
+	config, err := ReadLayeredConfig(suite.Layers)
+	suite.Require().NoError(err)
+	suite.Require().NoError(config.Validate())
+	config.OutputFormat = OneLine
+
+	return config
+}
+
+func (suite *LayeredConfigTestSuite) TestTemplates() {
+	config := suite.read()
+
+	formattedAddress, err := FormatAddress(&Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", CountryCode: "DE"}, config)
+	suite.NoError(err)
+	suite.Equal("Platz der Republik 1, D-11011 Berlin", formattedAddress)
+
+	layer, hasOrigin := config.Origin("templates", "DE", "address_template")
+	suite.True(hasOrigin)
+	suite.Equal("local", layer)
+	layer, _ = config.Origin("templates", "DE", "fallback_template")
+	suite.Equal("opencage", layer)
+	layer, _ = config.Origin("templates", "generic1")
+	suite.Equal("opencage", layer)
+}
+
+func (suite *LayeredConfigTestSuite) TestAppendReplacements() {
+	config := suite.read()
+
+	rules := config.Templates["US"].(map[string]interface{})["postformat_replace"].([]interface{})
+	suite.Len(rules, 3)
+	suite.Equal([]interface{}{" Avenue\n", " Ave\n"}, rules[2])
+
+	layer, _ := config.Origin("templates", "US", "postformat_replace", "0")
+	suite.Equal("opencage", layer)
+	layer, _ = config.Origin("templates", "US", "postformat_replace", "2")
+	suite.Equal("local", layer)
+}
+
+func (suite *LayeredConfigTestSuite) TestReplaceReplacements() {
+	suite.Layers[1].Replacements = MergeReplace
+	config := suite.read()
+
+	rules := config.Templates["US"].(map[string]interface{})["postformat_replace"].([]interface{})
+	suite.Equal([]interface{}{[]interface{}{" Avenue\n", " Ave\n"}}, rules)
+
+	layer, _ := config.Origin("templates", "US", "postformat_replace", "0")
+	suite.Equal("local", layer)
+	_, hasOrigin := config.Origin("templates", "US", "postformat_replace", "1")
+	suite.False(hasOrigin)
+
+	// rules the layer does not define are kept
+	suite.Len(config.Templates["US"].(map[string]interface{})["replace"], 1)
+}
+
+func (suite *LayeredConfigTestSuite) TestComponentAliases() {
+	config := suite.read()
+
+	address, err := GetFixedAddress(map[string]string{"strasse_intern": "Platz der Republik", "plz_intern": "11011", "country_code": "DE"}, config)
+	suite.NoError(err)
+	suite.Equal("Platz der Republik", address.Road)
+	suite.Equal("11011", address.Postcode)
+
+	// aliases of the previous layers take precedence
+	address, err = GetFixedAddress(map[string]string{"street": "Platz der Republik", "strasse_intern": "Wilhelmstraße", "country_code": "DE"}, config)
+	suite.NoError(err)
+	suite.Equal("Platz der Republik", address.Road)
+
+	layer, _ := config.Origin("component_aliases", "strasse_intern")
+	suite.Equal("local", layer)
+	layer, _ = config.Origin("component_aliases", "street")
+	suite.Equal("opencage", layer)
+}
+
+func (suite *LayeredConfigTestSuite) TestMergeMaps() {
+	config := suite.read()
+
+	suite.Equal("Puerto Rico", config.StateCodes["US"]["PR"])
+	suite.Equal("California", config.StateCodes["US"]["CA"])
+	suite.Equal("Al", config.Abbreviations["de"]["road"]["Allee"])
+	suite.Equal("Str", config.Abbreviations["de"]["road"]["Straße"])
+	suite.Equal("Spain", config.CountryCodes["ES"])
+
+	layer, _ := config.Origin("state_codes", "US", "PR")
+	suite.Equal("local", layer)
+	layer, _ = config.Origin("state_codes", "US", "CA")
+	suite.Equal("opencage", layer)
+	layer, _ = config.Origin("abbreviations", "de", "road", "Allee")
+	suite.Equal("local", layer)
+	layer, _ = config.Origin("country_to_lang", "DE")
+	suite.Equal("opencage", layer)
+}
+
+func (suite *LayeredConfigTestSuite) TestMissingFiles() {
+	suite.Layers[1].Files.StateCodesPath = "testdata/overlay/missing.yaml"
+	_, err := ReadLayeredConfig(suite.Layers)
+	suite.ErrorIs(err, os.ErrNotExist)
+
+	suite.Layers[0].Files.StateCodesPath = ""
+	suite.Layers[1].Files.StateCodesPath = ""
+	_, err = ReadLayeredConfig(suite.Layers)
+	suite.Error(err)
+
+	_, err = ReadLayeredConfig(nil)
+	suite.Error(err)
+}
+
+func (suite *LayeredConfigTestSuite) TestReadConfigHasNoOrigins() {
+	config, err := ReadConfig(suite.Layers[0].Files)
+	suite.Require().NoError(err)
+
+	_, hasOrigin := config.Origin("templates", "DE", "address_template")
+	suite.False(hasOrigin)
+}
@@ -0,0 +1,77 @@
+package addrFmt
+
+import (
+	"context"
+	"github.com/timonmasberg/address-formatter/iso3166"
+	"strings"
+)
+
+// FormatForMailing formats an Address for a letter sent from the origin country following the UPU S42 conventions.
+// The layout of the destination country is kept, domestic mail has no country line and international mail ends with
+// the destination country in the language of the letter (see WithLanguage), of the origin country or in english
+// if there is no translation
+func FormatForMailing(address *Address, originCountryCode string, config *Config, opts ...Option) (interface{}, error) {
+	if address == nil {
+		return nil, ErrNilAddress
+	}
+
+	o := newOptions(opts)
+
+	originCountryCode = getFixedCountryCode(originCountryCode)
+	destinationCountryCode := getDestinationCountryCode(address)
+
+	o.homeCountry = originCountryCode
+	if destinationCountryCode == "" || destinationCountryCode != originCountryCode {
+		o.countryLine = getMailingCountryName(destinationCountryCode, address.Country, getOutputLanguages(originCountryCode, config, o.language))
+	}
+
+	return formatAddress(context.Background(), address, config, o)
+}
+
+func getDestinationCountryCode(address *Address) string {
+	if countryCode, _, isSubdivision := iso3166.ParseSubdivision(address.StateCode); isSubdivision && address.CountryCode == "" {
+		return countryCode
+	}
+
+	return getFixedCountryCode(address.CountryCode)
+}
+
+// the country name is taken from the first language of the origin country that has a translation
+func getMailingCountryName(countryCode string, country string, languages []string) string {
+	names, hasNames := countryNames[countryCode]
+	if !hasNames {
+		return country
+	}
+
+	for _, language := range languages {
+		if name, hasTranslation := names[language]; hasTranslation {
+			return name
+		}
+	}
+
+	return names["en"]
+}
+
+// getLanguage returns the main language of a country
+func getLanguage(countryCode string, config *Config) string {
+	if languages := getCountryLanguages(countryCode, config); len(languages) > 0 {
+		return languages[0]
+	}
+
+	return ""
+}
+
+// getCountryLanguages returns the languages spoken in a country as configured in country2lang.yaml
+func getCountryLanguages(countryCode string, config *Config) []string {
+	languages, hasLanguages := config.CountryToLang[countryCode].(string)
+	if !hasLanguages {
+		return nil
+	}
+
+	languageList := strings.Split(languages, ",")
+	for i, language := range languageList {
+		languageList[i] = strings.TrimSpace(language)
+	}
+
+	return languageList
+}
@@ -0,0 +1,96 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestMailingTestSuite(t *testing.T) {
+	suite.Run(t, new(MailingTestSuite))
+}
+
+type MailingTestSuite struct {
+	suite.Suite
+	Config  *Config
+	Address *Address
+}
+
+func (suite *MailingTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = PostalFormat
+
+	suite.Address = &Address{
+		House:       "Bundestag",
+		HouseNumber: "1",
+		Road:        "Platz der Republik",
+		City:        "Berlin",
+		Postcode:    "11011",
+		Country:     "Deutschland",
+		CountryCode: "DE",
+	}
+}
+
+func (suite *MailingTestSuite) TestDomesticMail() {
+	formattedAddress, err := FormatForMailing(suite.Address, "de", suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\n", formattedAddress)
+}
+
+func (suite *MailingTestSuite) TestInternationalMail() {
+	formattedAddress, err := FormatForMailing(suite.Address, "US", suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\nGermany\n", formattedAddress,
+		"Country line should be in the language of the origin country")
+
+	formattedAddress, err = FormatForMailing(suite.Address, "ES", suite.Config, WithUppercase(UppercaseLastLines))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 BERLIN\nALEMANIA\n", formattedAddress)
+
+	formattedAddress, err = FormatForMailing(suite.Address, "JP", suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\nGermany\n", formattedAddress,
+		"Country line should be in english if there is no language configured for the origin country")
+}
+
+func (suite *MailingTestSuite) TestCountryLineIsLast() {
+	address := &Address{
+		HouseNumber: "1600",
+		Road:        "Pennsylvania Avenue NW",
+		City:        "Washington",
+		StateCode:   "US-DC",
+		Postcode:    "20500",
+	}
+
+	formattedAddress, err := FormatForMailing(address, "DE", suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("1600 Pennsylvania Avenue NW\nWashington, DC 20500\nVereinigte Staaten\n", formattedAddress)
+}
+
+func (suite *MailingTestSuite) TestUnknownDestination() {
+	address := &Address{Road: "Main Street", HouseNumber: "1", City: "Atlantis", Country: "Atlantis"}
+
+	formattedAddress, err := FormatForMailing(address, "DE", suite.Config, WithUppercase(UppercaseLastLines))
+
+	suite.NoError(err)
+	suite.Equal("Main Street 1\nATLANTIS\n", formattedAddress)
+}
+
+func (suite *MailingTestSuite) TestCountryLineAfterLineOfSameName() {
+	address := &Address{House: "Atlantis", Road: "Main Street", HouseNumber: "1", City: "Poseidonia", Country: "Atlantis"}
+
+	formattedAddress, err := FormatForMailing(address, "DE", suite.Config, WithUppercase(UppercaseLastLines))
+
+	suite.NoError(err)
+	suite.Equal("Atlantis\nMain Street 1\nPOSEIDONIA\nATLANTIS\n", formattedAddress)
+}
+
+func (suite *MailingTestSuite) TestNilAddress() {
+	_, err := FormatForMailing(nil, "DE", suite.Config)
+
+	suite.ErrorIs(err, ErrNilAddress)
+}
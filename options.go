@@ -0,0 +1,96 @@
+package addrFmt
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Option changes how a single address is formatted
+type Option func(*options)
+
+type UppercaseMode int
+
+const (
+	UppercaseNone UppercaseMode = iota
+	// UppercaseLastLines uppercases the locality line and the country line as many postal operators require
+	UppercaseLastLines UppercaseMode = iota
+	UppercaseAll       UppercaseMode = iota
+)
+
+type options struct {
+	uppercase       UppercaseMode
+	homeCountry     string
+	transliteration Transliteration
+	script          Script
+	language        string
+	workers         int
+	// countryLine replaces the country component with a line appended after the rendered address
+	countryLine string
+}
+
+// WithUppercase uppercases the formatted address according to mode
+func WithUppercase(mode UppercaseMode) Option {
+	return func(o *options) {
+		o.uppercase = mode
+	}
+}
+
+// WithHomeCountry omits the country line of domestic addresses, it takes precedence over Config.HomeCountry
+func WithHomeCountry(countryCode string) Option {
+	return func(o *options) {
+		o.homeCountry = countryCode
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := new(options)
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// applyUppercase uppercases all lines or the last line and the one before if the address ends with a country line
+func applyUppercase(render string, mode UppercaseMode, hasCountryLine bool, language string) string {
+	switch mode {
+	case UppercaseAll:
+		return toUpper(render, language)
+	case UppercaseLastLines:
+		lines := strings.Split(render, "\n")
+		lastLines := 1
+		if hasCountryLine {
+			lastLines = 2
+		}
+
+		for i := len(lines) - lastLines; i < len(lines); i++ {
+			if i >= 0 {
+				lines[i] = toUpper(lines[i], language)
+			}
+		}
+
+		return strings.Join(lines, "\n")
+	default:
+		return render
+	}
+}
+
+var uppercaseReplacer = strings.NewReplacer(
+	// no single uppercase letter
+	"ß", "SS", "ﬀ", "FF", "ﬁ", "FI", "ﬂ", "FL", "ﬃ", "FFI", "ﬄ", "FFL", "ﬅ", "ST", "ﬆ", "ST",
+	// greek capitals are written without accents
+	"Ά", "Α", "Έ", "Ε", "Ή", "Η", "Ί", "Ι", "Ό", "Ο", "Ύ", "Υ", "Ώ", "Ω", "ΐ", "Ϊ", "ΰ", "Ϋ",
+)
+
+// toUpper uppercases with the rules of the language, e.g. Turkish and Azerbaijani keep the dot of i (İ)
+func toUpper(value string, language string) string {
+	switch language {
+	case "tr", "az":
+		value = strings.ToUpperSpecial(unicode.TurkishCase, value)
+	default:
+		value = strings.ToUpper(value)
+	}
+
+	return uppercaseReplacer.Replace(value)
+}
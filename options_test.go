@@ -0,0 +1,97 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
+
+type OptionsTestSuite struct {
+	suite.Suite
+	Config  *Config
+	Address *Address
+}
+
+func (suite *OptionsTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = PostalFormat
+
+	suite.Address = &Address{
+		House:       "Bundestag",
+		HouseNumber: "1",
+		Road:        "Platz der Republik",
+		City:        "Berlin",
+		Postcode:    "11011",
+		Country:     "Deutschland",
+		CountryCode: "DE",
+	}
+}
+
+func (suite *OptionsTestSuite) TestUppercase() {
+	formattedAddress, err := FormatAddress(suite.Address, suite.Config, WithUppercase(UppercaseLastLines))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 BERLIN\nDEUTSCHLAND\n", formattedAddress)
+
+	suite.Address.Country = ""
+	formattedAddress, err = FormatAddress(suite.Address, suite.Config, WithUppercase(UppercaseLastLines))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 BERLIN\n", formattedAddress)
+}
+
+func (suite *OptionsTestSuite) TestHomeCountry() {
+	formattedAddress, err := FormatAddress(suite.Address, suite.Config, WithHomeCountry("DE"))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\n", formattedAddress)
+
+	formattedAddress, err = FormatAddress(suite.Address, suite.Config, WithHomeCountry("DEU"))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\n", formattedAddress, "Home country should accept ISO codes")
+
+	formattedAddress, err = FormatAddress(suite.Address, suite.Config, WithHomeCountry("AT"))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\nDeutschland\n", formattedAddress)
+}
+
+func (suite *OptionsTestSuite) TestConfigHomeCountry() {
+	suite.Config.HomeCountry = "DE"
+
+	formattedAddress, err := FormatAddress(suite.Address, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\n", formattedAddress)
+
+	formattedAddress, err = FormatAddress(suite.Address, suite.Config, WithHomeCountry("AT"))
+
+	suite.NoError(err)
+	suite.Equal("Bundestag\nPlatz der Republik 1\n11011 Berlin\nDeutschland\n", formattedAddress,
+		"Home country of the call should take precedence")
+}
+
+func (suite *OptionsTestSuite) TestHomeCountryPostformatArtefacts() {
+	var template template
+	suite.NoError(yaml.Unmarshal([]byte(`
+address_template: |
+    {{{road}}} {{{house_number}}}
+    {{{postcode}}} {{{city}}}
+    {{{country}}}
+postformat_replace:
+    - ["(\\d{5} \\w+)$", "$1\nSingapore"]
+`), &template))
+	suite.Config.Templates["SG"] = template
+
+	address := &Address{Road: "Orchard Road", HouseNumber: "1", Postcode: "23880", City: "Singapore", CountryCode: "SG"}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithHomeCountry("SG"))
+
+	suite.NoError(err)
+	suite.Equal("Orchard Road 1\n23880 Singapore\n", formattedAddress)
+}
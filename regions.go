@@ -0,0 +1,79 @@
+package addrFmt
+
+// CountryRegion describes where a country is located by its continent and UN M49 areas
+type CountryRegion struct {
+	Continent              string
+	Region                 string
+	RegionCode             string
+	SubRegion              string
+	SubRegionCode          string
+	IntermediateRegion     string
+	IntermediateRegionCode string
+	EUMember               bool
+}
+
+type m49Area struct {
+	code   string
+	name   string
+	parent string
+}
+
+const m49World = "001"
+
+var euMembers = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "HR": true, "CY": true, "CZ": true, "DK": true, "EE": true, "FI": true,
+	"FR": true, "DE": true, "GR": true, "HU": true, "IE": true, "IT": true, "LV": true, "LT": true, "LU": true,
+	"MT": true, "NL": true, "PL": true, "PT": true, "RO": true, "SK": true, "SI": true, "ES": true, "SE": true,
+}
+
+// GetCountryRegion returns the continent, UN M49 region, sub-region and intermediate region and the EU membership
+// of a country. Alpha-2, alpha-3 and numeric country codes are accepted
+func GetCountryRegion(countryCode string) (CountryRegion, bool) {
+	countryCode = getFixedCountryCode(countryCode)
+
+	if countryCode == "AQ" {
+		return CountryRegion{Continent: "Antarctica"}, true
+	}
+
+	areaCode, hasArea := countryM49Areas[countryCode]
+	if !hasArea {
+		return CountryRegion{}, false
+	}
+
+	// walk up from the most specific area until the world is reached
+	var areas []m49Area
+	for areaCode != m49World {
+		area := m49Areas[areaCode]
+		areas = append([]m49Area{area}, areas...)
+		areaCode = area.parent
+	}
+
+	region := CountryRegion{
+		Region:        areas[0].name,
+		RegionCode:    areas[0].code,
+		SubRegion:     areas[1].name,
+		SubRegionCode: areas[1].code,
+		EUMember:      euMembers[countryCode],
+	}
+	if len(areas) > 2 {
+		region.IntermediateRegion = areas[2].name
+		region.IntermediateRegionCode = areas[2].code
+	}
+
+	region.Continent = getContinent(region)
+
+	return region, true
+}
+
+// the americas are split into north and south america, every other continent equals its M49 region
+func getContinent(region CountryRegion) string {
+	if region.RegionCode != "019" {
+		return region.Region
+	}
+
+	if region.IntermediateRegionCode == "005" {
+		return "South America"
+	}
+
+	return "North America"
+}
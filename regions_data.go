@@ -0,0 +1,288 @@
+package addrFmt
+
+// m49Areas holds the UN M49 regions, sub-regions and intermediate regions with their parent area
+var m49Areas = map[string]m49Area{
+	"001": {code: "001", name: "World", parent: ""},
+	"002": {code: "002", name: "Africa", parent: "001"},
+	"015": {code: "015", name: "Northern Africa", parent: "002"},
+	"202": {code: "202", name: "Sub-Saharan Africa", parent: "002"},
+	"014": {code: "014", name: "Eastern Africa", parent: "202"},
+	"017": {code: "017", name: "Middle Africa", parent: "202"},
+	"018": {code: "018", name: "Southern Africa", parent: "202"},
+	"011": {code: "011", name: "Western Africa", parent: "202"},
+	"019": {code: "019", name: "Americas", parent: "001"},
+	"419": {code: "419", name: "Latin America and the Caribbean", parent: "019"},
+	"029": {code: "029", name: "Caribbean", parent: "419"},
+	"013": {code: "013", name: "Central America", parent: "419"},
+	"005": {code: "005", name: "South America", parent: "419"},
+	"021": {code: "021", name: "Northern America", parent: "019"},
+	"142": {code: "142", name: "Asia", parent: "001"},
+	"143": {code: "143", name: "Central Asia", parent: "142"},
+	"030": {code: "030", name: "Eastern Asia", parent: "142"},
+	"035": {code: "035", name: "South-eastern Asia", parent: "142"},
+	"034": {code: "034", name: "Southern Asia", parent: "142"},
+	"145": {code: "145", name: "Western Asia", parent: "142"},
+	"150": {code: "150", name: "Europe", parent: "001"},
+	"151": {code: "151", name: "Eastern Europe", parent: "150"},
+	"154": {code: "154", name: "Northern Europe", parent: "150"},
+	"039": {code: "039", name: "Southern Europe", parent: "150"},
+	"155": {code: "155", name: "Western Europe", parent: "150"},
+	"009": {code: "009", name: "Oceania", parent: "001"},
+	"053": {code: "053", name: "Australia and New Zealand", parent: "009"},
+	"054": {code: "054", name: "Melanesia", parent: "009"},
+	"057": {code: "057", name: "Micronesia", parent: "009"},
+	"061": {code: "061", name: "Polynesia", parent: "009"},
+}
+
+// countryM49Areas maps ISO 3166-1 alpha-2 codes to the most specific UN M49 area of the country
+var countryM49Areas = map[string]string{
+	"AD": "039",
+	"AE": "145",
+	"AF": "034",
+	"AG": "029",
+	"AI": "029",
+	"AL": "039",
+	"AM": "145",
+	"AO": "017",
+	"AR": "005",
+	"AS": "061",
+	"AT": "155",
+	"AU": "053",
+	"AW": "029",
+	"AX": "154",
+	"AZ": "145",
+	"BA": "039",
+	"BB": "029",
+	"BD": "034",
+	"BE": "155",
+	"BF": "011",
+	"BG": "151",
+	"BH": "145",
+	"BI": "014",
+	"BJ": "011",
+	"BL": "029",
+	"BM": "021",
+	"BN": "035",
+	"BO": "005",
+	"BQ": "029",
+	"BR": "005",
+	"BS": "029",
+	"BT": "034",
+	"BV": "005",
+	"BW": "018",
+	"BY": "151",
+	"BZ": "013",
+	"CA": "021",
+	"CC": "053",
+	"CD": "017",
+	"CF": "017",
+	"CG": "017",
+	"CH": "155",
+	"CI": "011",
+	"CK": "061",
+	"CL": "005",
+	"CM": "017",
+	"CN": "030",
+	"CO": "005",
+	"CR": "013",
+	"CU": "029",
+	"CV": "011",
+	"CW": "029",
+	"CX": "053",
+	"CY": "145",
+	"CZ": "151",
+	"DE": "155",
+	"DJ": "014",
+	"DK": "154",
+	"DM": "029",
+	"DO": "029",
+	"DZ": "015",
+	"EC": "005",
+	"EE": "154",
+	"EG": "015",
+	"EH": "015",
+	"ER": "014",
+	"ES": "039",
+	"ET": "014",
+	"FI": "154",
+	"FJ": "054",
+	"FK": "005",
+	"FM": "057",
+	"FO": "154",
+	"FR": "155",
+	"GA": "017",
+	"GB": "154",
+	"GD": "029",
+	"GE": "145",
+	"GF": "005",
+	"GG": "154",
+	"GH": "011",
+	"GI": "039",
+	"GL": "021",
+	"GM": "011",
+	"GN": "011",
+	"GP": "029",
+	"GQ": "017",
+	"GR": "039",
+	"GS": "005",
+	"GT": "013",
+	"GU": "057",
+	"GW": "011",
+	"GY": "005",
+	"HK": "030",
+	"HM": "053",
+	"HN": "013",
+	"HR": "039",
+	"HT": "029",
+	"HU": "151",
+	"ID": "035",
+	"IE": "154",
+	"IL": "145",
+	"IM": "154",
+	"IN": "034",
+	"IO": "014",
+	"IQ": "145",
+	"IR": "034",
+	"IS": "154",
+	"IT": "039",
+	"JE": "154",
+	"JM": "029",
+	"JO": "145",
+	"JP": "030",
+	"KE": "014",
+	"KG": "143",
+	"KH": "035",
+	"KI": "057",
+	"KM": "014",
+	"KN": "029",
+	"KP": "030",
+	"KR": "030",
+	"KW": "145",
+	"KY": "029",
+	"KZ": "143",
+	"LA": "035",
+	"LB": "145",
+	"LC": "029",
+	"LI": "155",
+	"LK": "034",
+	"LR": "011",
+	"LS": "018",
+	"LT": "154",
+	"LU": "155",
+	"LV": "154",
+	"LY": "015",
+	"MA": "015",
+	"MC": "155",
+	"MD": "151",
+	"ME": "039",
+	"MF": "029",
+	"MG": "014",
+	"MH": "057",
+	"MK": "039",
+	"ML": "011",
+	"MM": "035",
+	"MN": "030",
+	"MO": "030",
+	"MP": "057",
+	"MQ": "029",
+	"MR": "011",
+	"MS": "029",
+	"MT": "039",
+	"MU": "014",
+	"MV": "034",
+	"MW": "014",
+	"MX": "013",
+	"MY": "035",
+	"MZ": "014",
+	"NA": "018",
+	"NC": "054",
+	"NE": "011",
+	"NF": "053",
+	"NG": "011",
+	"NI": "013",
+	"NL": "155",
+	"NO": "154",
+	"NP": "034",
+	"NR": "057",
+	"NU": "061",
+	"NZ": "053",
+	"OM": "145",
+	"PA": "013",
+	"PE": "005",
+	"PF": "061",
+	"PG": "054",
+	"PH": "035",
+	"PK": "034",
+	"PL": "151",
+	"PM": "021",
+	"PN": "061",
+	"PR": "029",
+	"PS": "145",
+	"PT": "039",
+	"PW": "057",
+	"PY": "005",
+	"QA": "145",
+	"RE": "014",
+	"RO": "151",
+	"RS": "039",
+	"RU": "151",
+	"RW": "014",
+	"SA": "145",
+	"SB": "054",
+	"SC": "014",
+	"SD": "015",
+	"SE": "154",
+	"SG": "035",
+	"SH": "011",
+	"SI": "039",
+	"SJ": "154",
+	"SK": "151",
+	"SL": "011",
+	"SM": "039",
+	"SN": "011",
+	"SO": "014",
+	"SR": "005",
+	"SS": "014",
+	"ST": "017",
+	"SV": "013",
+	"SX": "029",
+	"SY": "145",
+	"SZ": "018",
+	"TC": "029",
+	"TD": "017",
+	"TF": "014",
+	"TG": "011",
+	"TH": "035",
+	"TJ": "143",
+	"TK": "061",
+	"TL": "035",
+	"TM": "143",
+	"TN": "015",
+	"TO": "061",
+	"TR": "145",
+	"TT": "029",
+	"TV": "061",
+	"TW": "030",
+	"TZ": "014",
+	"UA": "151",
+	"UG": "014",
+	"UM": "057",
+	"US": "021",
+	"UY": "005",
+	"UZ": "143",
+	"VA": "039",
+	"VC": "029",
+	"VE": "005",
+	"VG": "029",
+	"VI": "029",
+	"VN": "035",
+	"VU": "054",
+	"WF": "061",
+	"WS": "061",
+	"XK": "039",
+	"YE": "145",
+	"YT": "014",
+	"ZA": "018",
+	"ZM": "014",
+	"ZW": "014",
+}
@@ -0,0 +1,88 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestRegionsTestSuite(t *testing.T) {
+	suite.Run(t, new(RegionsTestSuite))
+}
+
+type RegionsTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *RegionsTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+}
+
+func (suite *RegionsTestSuite) TestGetCountryRegion() {
+	region, hasRegion := GetCountryRegion("DE")
+	suite.True(hasRegion)
+	suite.Equal(CountryRegion{
+		Continent:     "Europe",
+		Region:        "Europe",
+		RegionCode:    "150",
+		SubRegion:     "Western Europe",
+		SubRegionCode: "155",
+		EUMember:      true,
+	}, region)
+
+	region, hasRegion = GetCountryRegion("BRA")
+	suite.True(hasRegion)
+	suite.Equal(CountryRegion{
+		Continent:              "South America",
+		Region:                 "Americas",
+		RegionCode:             "019",
+		SubRegion:              "Latin America and the Caribbean",
+		SubRegionCode:          "419",
+		IntermediateRegion:     "South America",
+		IntermediateRegionCode: "005",
+	}, region)
+
+	continents := map[string]string{
+		"US": "North America",
+		"MX": "North America",
+		"JM": "North America",
+		"GB": "Europe",
+		"JP": "Asia",
+		"NG": "Africa",
+		"NZ": "Oceania",
+		"AQ": "Antarctica",
+	}
+	for countryCode, continent := range continents {
+		region, hasRegion = GetCountryRegion(countryCode)
+
+		suite.True(hasRegion, "%s should have a region", countryCode)
+		suite.Equal(continent, region.Continent, "%s is on the wrong continent", countryCode)
+	}
+
+	_, hasRegion = GetCountryRegion("XX")
+	suite.False(hasRegion)
+}
+
+func (suite *RegionsTestSuite) TestCountriesHaveRegions() {
+	for countryCode := range countryNames {
+		region, hasRegion := GetCountryRegion(countryCode)
+
+		suite.True(hasRegion, "%s should have a region", countryCode)
+		suite.NotEmpty(region.Continent, "%s should have a continent", countryCode)
+	}
+}
+
+func (suite *RegionsTestSuite) TestGetFixedAddressAddsContinent() {
+	address, err := GetFixedAddress(addressMap{"country_code": "de", "city": "Berlin"}, suite.Config)
+	suite.NoError(err)
+	suite.Empty(address.Continent, "Continent should only be added if enabled")
+
+	suite.Config.AddContinent = true
+	address, err = GetFixedAddress(addressMap{"country_code": "de", "city": "Berlin"}, suite.Config)
+	suite.NoError(err)
+	suite.Equal("Europe", address.Continent)
+
+	address, err = GetFixedAddress(addressMap{"country_code": "de", "continent": "Eurasia"}, suite.Config)
+	suite.NoError(err)
+	suite.Equal("Eurasia", address.Continent, "Provided continent should not be overwritten")
+}
@@ -0,0 +1,126 @@
+package addrFmt
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRenderTestSuite(t *testing.T) {
+	suite.Run(t, new(RenderTestSuite))
+}
+
+type RenderTestSuite struct {
+	suite.Suite
+}
+
+// legacyCleanupRender is the previous implementation cleanupRender has to match exactly
+func legacyCleanupRender(render string) string {
+	for _, replacement := range replacements {
+		render = replacement.pattern.ReplaceAllString(render, replacement.replace)
+
+		render = legacyDedupe(strings.Split(render, "\n"), "\n", func(s string) string {
+			return legacyDedupe(strings.Split(s, ", "), ", ", func(s string) string {
+				return s
+			})
+		})
+	}
+
+	return strings.TrimSpace(render)
+}
+
+func legacyDedupe(chunks []string, glue string, modifier func(s string) string) string {
+	seen := make(map[string]bool)
+	result := make([]string, 0)
+
+	for _, chunk := range chunks {
+		chunk = strings.TrimSpace(chunk)
+		if strings.ToLower(chunk) == "new york" {
+			seen[chunk] = true
+			result = append(result, chunk)
+		} else if seenChunk, hasChunk := seen[chunk]; !hasChunk || !seenChunk {
+			seen[chunk] = true
+			result = append(result, modifier(chunk))
+		}
+	}
+
+	return strings.Join(result, glue)
+}
+
+func (suite *RenderTestSuite) TestCleanupRenderMatchesLegacy() {
+	tokens := []string{
+		"Berlin", "berlin", "New York", "NEW YORK", "a", "b", "11011", ",", ", ", ",,", "\n", "\n\n", " ", "  ", "\t",
+		"-", "- ", "}", "/", " , ", ",\n", "\n,", "\n ",
+	}
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20000; i++ {
+		var builder strings.Builder
+		for j := random.Intn(25); j >= 0; j-- {
+			builder.WriteString(tokens[random.Intn(len(tokens))])
+		}
+		render := builder.String()
+
+		cleanRender, err := cleanupRender(context.Background(), render)
+
+		suite.NoError(err)
+		suite.Equalf(legacyCleanupRender(render), cleanRender, "render: %q", render)
+	}
+}
+
+func (suite *RenderTestSuite) TestCleanupRender() {
+	renders := map[string]string{
+		"Bundestag\n Platz der Republik 1\n\n11011 Berlin, Berlin\nBerlin\nGermany\n": "Bundestag\nPlatz der Republik 1\n11011 Berlin, Berlin\nBerlin\nGermany",
+		"New York, New York\nNew York\nNew York":                                      "New York, New York\nNew York\nNew York",
+		" , Main Street,, 5\n,\n":                                                     "Main Street, 5",
+		"":                                                                            "",
+	}
+
+	for render, expectedRender := range renders {
+		cleanRender, err := cleanupRender(context.Background(), render)
+
+		suite.NoError(err)
+		suite.Equal(expectedRender, cleanRender, render)
+	}
+}
+
+func (suite *RenderTestSuite) TestAddressFieldsCoverAddress() {
+	address := Address{}
+	for _, field := range addressFields {
+		*field.value(&address) = field.component
+	}
+
+	addressMap, err := addressToMap(&address)
+
+	suite.NoError(err)
+	suite.Equal(reflect.TypeOf(address).NumField(), len(addressFields))
+	suite.Len(addressMap, len(addressFields))
+	suite.Equal(&address, mapToAddress(addressMap, nil, false))
+}
+
+func (suite *RenderTestSuite) TestCachesAreBoundToConfig() {
+	countries := []byte("default:\n  address_template: \"{{{road}}}\"\n  fallback_template: \"{{{road}}}\"\n  postformat_replace: [[\"Platz\", \"Pl.\"]]\n")
+	config, err := ParseConfig(ConfigData{Countries: countries})
+	suite.Require().NoError(err)
+	otherConfig, err := ParseConfig(ConfigData{Countries: countries})
+	suite.Require().NoError(err)
+
+	_, err = FormatAddress(&Address{Road: "Platz der Republik"}, config)
+	suite.Require().NoError(err)
+
+	_, isParsed := config.cache.templates.Load("{{{road}}}")
+	suite.True(isParsed)
+	_, isCompiled := config.cache.patterns.Load("Platz")
+	suite.True(isCompiled)
+	_, isParsed = otherConfig.cache.templates.Load("{{{road}}}")
+	suite.False(isParsed)
+
+	// configs that are not created by the package render without a cache
+	manualConfig := &Config{Templates: config.Templates, OutputFormat: OneLine}
+	formattedAddress, err := FormatAddress(&Address{Road: "Platz der Republik"}, manualConfig)
+	suite.NoError(err)
+	suite.Equal("Pl. der Republik", formattedAddress)
+}
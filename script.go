@@ -0,0 +1,156 @@
+package addrFmt
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Script is an ISO 15924 script code such as "Latn" or "Jpan"
+type Script string
+
+const (
+	// ScriptDefault renders the address with the country template, it is the default
+	ScriptDefault Script = ""
+	// ScriptAuto detects the script from the address components
+	ScriptAuto     Script = "Auto"
+	ScriptLatin    Script = "Latn"
+	ScriptCyrillic Script = "Cyrl"
+	ScriptGreek    Script = "Grek"
+	ScriptArabic   Script = "Arab"
+	ScriptHebrew   Script = "Hebr"
+	ScriptHan      Script = "Hani"
+	// ScriptHanSimplified is used by CN
+	ScriptHanSimplified Script = "Hans"
+	// ScriptHanTraditional is used by TW, HK and MO
+	ScriptHanTraditional Script = "Hant"
+	ScriptHangul         Script = "Hang"
+	// ScriptJapanese is Han mixed with kana
+	ScriptJapanese Script = "Jpan"
+	// ScriptKorean is Hangul mixed with Han
+	ScriptKorean Script = "Kore"
+)
+
+// WithScript chooses the template variant of a script, ScriptAuto detects the script of the components
+func WithScript(script Script) Option {
+	return func(o *options) {
+		o.script = script
+	}
+}
+
+var scriptRanges = []struct {
+	script Script
+	table  *unicode.RangeTable
+}{
+	{script: ScriptLatin, table: unicode.Latin},
+	{script: ScriptCyrillic, table: unicode.Cyrillic},
+	{script: ScriptGreek, table: unicode.Greek},
+	{script: ScriptArabic, table: unicode.Arabic},
+	{script: ScriptHebrew, table: unicode.Hebrew},
+	{script: ScriptHan, table: unicode.Han},
+	{script: ScriptHangul, table: unicode.Hangul},
+	{script: ScriptJapanese, table: unicode.Hiragana},
+	{script: ScriptJapanese, table: unicode.Katakana},
+}
+
+// ties are resolved in this order
+var detectableScripts = []Script{
+	ScriptLatin, ScriptCyrillic, ScriptGreek, ScriptArabic, ScriptHebrew, ScriptJapanese, ScriptKorean, ScriptHan, ScriptHangul,
+}
+
+// the native script of countries writing their addresses big-to-small
+var cjkScripts = map[string]Script{
+	"JP": ScriptJapanese,
+	"CN": ScriptHanSimplified,
+	"TW": ScriptHanTraditional,
+	"HK": ScriptHanTraditional,
+	"MO": ScriptHanTraditional,
+	"KR": ScriptKorean,
+	"KP": ScriptKorean,
+}
+
+// the variants of a country template in worldwide.yaml are keyed by language, e.g. CN_zh and CN_en
+var scriptLanguages = map[Script]string{
+	ScriptLatin:          "en",
+	ScriptCyrillic:       "ru",
+	ScriptGreek:          "el",
+	ScriptArabic:         "ar",
+	ScriptHebrew:         "he",
+	ScriptHan:            "zh",
+	ScriptHanSimplified:  "zh",
+	ScriptHanTraditional: "zh",
+	ScriptHangul:         "ko",
+	ScriptJapanese:       "ja",
+	ScriptKorean:         "ko",
+}
+
+// detectScript returns the script most letters of the components are written in,
+// Han mixed with kana is Japanese and Hangul mixed with Han is Korean
+func detectScript(addressMap addressMap) Script {
+	counts := make(map[Script]int)
+
+	for component, value := range addressMap {
+		if strings.HasSuffix(component, "_code") {
+			continue
+		}
+
+		for _, r := range value {
+			for _, scriptRange := range scriptRanges {
+				if unicode.Is(scriptRange.table, r) {
+					counts[scriptRange.script]++
+					break
+				}
+			}
+		}
+	}
+
+	switch {
+	case counts[ScriptJapanese] > 0:
+		counts[ScriptJapanese] += counts[ScriptHan]
+	case counts[ScriptHangul] > 0:
+		counts[ScriptKorean] = counts[ScriptHangul] + counts[ScriptHan]
+	}
+
+	script := ScriptDefault
+	for _, candidate := range detectableScripts {
+		if counts[candidate] > counts[script] {
+			script = candidate
+		}
+	}
+
+	return script
+}
+
+// getCountryScript maps a generic CJK script to the one the country writes its addresses in
+func getCountryScript(countryCode string, script Script) Script {
+	switch script {
+	case ScriptHan, ScriptHanSimplified, ScriptHanTraditional, ScriptJapanese, ScriptHangul, ScriptKorean:
+		if countryScript, isCJK := cjkScripts[countryCode]; isCJK {
+			return countryScript
+		}
+	}
+
+	return script
+}
+
+// findScriptTemplate looks up the language variant of the country template for the script, e.g. CN_zh or CN_en, and
+// the country template if the config has no variant
+func findScriptTemplate(countryCode string, script Script, templates templateSet) template {
+	if language, hasLanguage := scriptLanguages[script]; hasLanguage && countryCode != "" {
+		if template, hasTemplate := templates.get(countryCode + "_" + language); hasTemplate {
+			return template
+		}
+	}
+
+	return findTemplate(countryCode, templates)
+}
+
+// findAddressTemplate finds the template of the country of the address in the script of the option, ScriptAuto uses
+// the script of its components
+func findAddressTemplate(addressMap addressMap, script Script, templates templateSet) template {
+	if script == ScriptAuto {
+		script = detectScript(addressMap)
+	}
+	script = getCountryScript(addressMap["country_code"], script)
+
+	return findScriptTemplate(addressMap["country_code"], script, templates)
+}
@@ -0,0 +1,143 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestScriptTestSuite(t *testing.T) {
+	suite.Run(t, new(ScriptTestSuite))
+}
+
+type ScriptTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *ScriptTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = PostalFormat
+}
+
+func (suite *ScriptTestSuite) TestDetectScript() {
+	scripts := map[string]Script{
+		"Platz der Republik": ScriptLatin,
+		"東京都新宿区":             ScriptHan,
+		"東京都しんじゅく":           ScriptJapanese,
+		"서울특별시 종로구":          ScriptKorean,
+		"Москва":             ScriptCyrillic,
+		"":                   ScriptDefault,
+	}
+
+	for value, script := range scripts {
+		suite.Equal(script, detectScript(addressMap{"road": value, "country_code": "JP"}), value)
+	}
+}
+
+func (suite *ScriptTestSuite) TestGetCountryScript() {
+	suite.Equal(ScriptJapanese, getCountryScript("JP", ScriptHan))
+	suite.Equal(ScriptHanSimplified, getCountryScript("CN", ScriptHan))
+	suite.Equal(ScriptHanTraditional, getCountryScript("TW", ScriptHan))
+	suite.Equal(ScriptKorean, getCountryScript("KR", ScriptHangul))
+	suite.Equal(ScriptLatin, getCountryScript("JP", ScriptLatin))
+	suite.Equal(ScriptHan, getCountryScript("DE", ScriptHan))
+}
+
+func (suite *ScriptTestSuite) TestJapaneseInKanji() {
+	address := &Address{
+		Quarter:      "西新宿二丁目",
+		HouseNumber:  "8-1",
+		House:        "東京都庁",
+		CityDistrict: "新宿区",
+		State:        "東京都",
+		Postcode:     "163-8001",
+		Country:      "日本",
+		CountryCode:  "JP",
+	}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithScript(ScriptAuto))
+
+	suite.NoError(err)
+	suite.Equal("日本\n〒163-8001\n東京都新宿区西新宿二丁目8-1\n東京都庁\n", formattedAddress)
+
+	formattedAddress, err = FormatAddress(address, suite.Config)
+
+	suite.NoError(err)
+	suite.Equal("東京都庁\n8-1 西新宿二丁目\n新宿区\n東京都 163-8001\n日本\n", formattedAddress)
+}
+
+func (suite *ScriptTestSuite) TestJapaneseInRomaji() {
+	address := &Address{
+		Quarter:      "Nishi-Shinjuku 2-chome",
+		HouseNumber:  "8-1",
+		House:        "Tokyo Metropolitan Government Building",
+		CityDistrict: "Shinjuku",
+		State:        "Tokyo",
+		Postcode:     "163-8001",
+		Country:      "Japan",
+		CountryCode:  "JP",
+	}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithScript(ScriptAuto))
+
+	suite.NoError(err)
+	suite.Equal("Tokyo Metropolitan Government Building\n8-1 Nishi-Shinjuku 2-chome\nShinjuku\nTokyo 163-8001\nJapan\n", formattedAddress)
+}
+
+func (suite *ScriptTestSuite) TestLanguageVariant() {
+	address := &Address{
+		Road:         "长安街",
+		HouseNumber:  "1",
+		City:         "北京市",
+		CityDistrict: "东城区",
+		Postcode:     "100006",
+		Country:      "中国",
+		CountryCode:  "CN",
+	}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithScript(ScriptAuto))
+
+	suite.NoError(err)
+	suite.Equal("中国\n100006\n北京市东城区长安街1\n", formattedAddress)
+
+	formattedAddress, err = FormatAddress(address, suite.Config, WithScript(ScriptLatin))
+
+	suite.NoError(err)
+	suite.Equal("长安街 1\n100006 北京市\n中国\n", formattedAddress)
+}
+
+func (suite *ScriptTestSuite) TestWithScript() {
+	address := &Address{
+		Quarter:      "Nishi-Shinjuku 2-chome",
+		HouseNumber:  "8-1",
+		CityDistrict: "Shinjuku",
+		State:        "Tokyo",
+		Postcode:     "163-8001",
+		CountryCode:  "JP",
+	}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithScript(ScriptHan))
+
+	suite.NoError(err)
+	suite.Equal("〒163-8001\nTokyoShinjukuNishi-Shinjuku 2-chome8-1\n", formattedAddress)
+
+	formattedAddress, err = FormatAddress(address, suite.Config, WithScript(ScriptLatin))
+
+	suite.NoError(err)
+	suite.Equal("8-1 Nishi-Shinjuku 2-chome\nShinjuku\nTokyo 163-8001\n", formattedAddress)
+}
+
+func (suite *ScriptTestSuite) TestTransliteratedAddressUsesLatinOrder() {
+	address := &Address{
+		Road:        "세종대로",
+		HouseNumber: "209",
+		City:        "종로구",
+		Postcode:    "03171",
+		CountryCode: "KR",
+	}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithTransliteration(TransliterateLatin), WithScript(ScriptAuto))
+
+	suite.NoError(err)
+	suite.Equal("sejongdaero 209\n03171 jongrogu\n", formattedAddress)
+}
@@ -0,0 +1,176 @@
+package addrFmt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TenantRegistry holds the template overlays of tenants on top of a shared base config. The config of a tenant looks
+// up templates in its overlay first and in the base config second, the templates of the base config are not copied
+type TenantRegistry struct {
+	mutex    sync.RWMutex
+	base     *Config
+	overlays map[string]map[string]interface{}
+	configs  map[string]*Config
+}
+
+// NewTenantRegistry creates a registry without tenants on top of base
+func NewTenantRegistry(base *Config) *TenantRegistry {
+	return &TenantRegistry{
+		base:     base,
+		overlays: make(map[string]map[string]interface{}),
+		configs:  make(map[string]*Config),
+	}
+}
+
+// Register validates the config of the tenant with the templates and replaces the overlay of the tenant. The keys of a country template override the
+// keys of the base template, so an overlay of {"DE": {"address_template": "..."}} keeps the replacements of DE
+func (r *TenantRegistry) Register(tenantID string, templates map[string]interface{}) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// the overlay is kept to apply it to a new base config, so later changes of the caller must not affect it
+	templates = copyTemplateValue(templates).(map[string]interface{})
+	config, err := newTenantConfig(r.base, templates)
+	if err != nil {
+		return fmt.Errorf("tenant %s: %w", tenantID, err)
+	}
+
+	r.overlays[tenantID] = templates
+	r.configs[tenantID] = config
+
+	return nil
+}
+
+// Unregister removes the overlay of the tenant
+func (r *TenantRegistry) Unregister(tenantID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.overlays, tenantID)
+	delete(r.configs, tenantID)
+}
+
+// Config returns the config of the tenant or the base config if the tenant has no overlay
+func (r *TenantRegistry) Config(tenantID string) *Config {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if config, hasOverlay := r.configs[tenantID]; hasOverlay {
+		return config
+	}
+
+	return r.base
+}
+
+// Tenants returns the IDs of the tenants with an overlay in ascending order
+func (r *TenantRegistry) Tenants() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	tenantIDs := make([]string, 0, len(r.configs))
+	for tenantID := range r.configs {
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	sort.Strings(tenantIDs)
+
+	return tenantIDs
+}
+
+// SetBase replaces the base config, e.g. after it was reloaded, and applies the overlays to it. Overlays that are
+// invalid with the new base config are removed and returned in the error
+func (r *TenantRegistry) SetBase(base *Config) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.base = base
+	configs := make(map[string]*Config, len(r.overlays))
+	var invalidTenants []string
+
+	for tenantID, templates := range r.overlays {
+		config, err := newTenantConfig(base, templates)
+		if err != nil {
+			delete(r.overlays, tenantID)
+			invalidTenants = append(invalidTenants, fmt.Sprintf("tenant %s: %v", tenantID, err))
+			continue
+		}
+		configs[tenantID] = config
+	}
+	r.configs = configs
+
+	if len(invalidTenants) > 0 {
+		sort.Strings(invalidTenants)
+		return fmt.Errorf("removed invalid overlays: %s", strings.Join(invalidTenants, "; "))
+	}
+
+	return nil
+}
+
+// newTenantConfig creates a shallow copy of base with the templates as overlay. Only the templates of the overlay
+// and the default template are validated, the templates of base are expected to be valid
+func newTenantConfig(base *Config, templates map[string]interface{}) (*Config, error) {
+	baseTemplates := base.getTemplates()
+	overlay := make(map[string]template, len(templates))
+	overlayTemplates := make(map[string]template, len(templates))
+
+	for name, overlayTemplate := range templates {
+		overlayTemplates[name] = overlayTemplate
+		overlayTemplateMap, isOverlayTemplateMap := overlayTemplate.(map[string]interface{})
+		baseTemplate, _ := baseTemplates.get(name)
+		baseTemplateMap, isBaseTemplateMap := baseTemplate.(map[string]interface{})
+
+		if !isOverlayTemplateMap || !isBaseTemplateMap {
+			overlay[name] = overlayTemplate
+			continue
+		}
+
+		templateMap := make(map[string]interface{}, len(baseTemplateMap)+len(overlayTemplateMap))
+		for key, value := range baseTemplateMap {
+			templateMap[key] = value
+		}
+		for key, value := range overlayTemplateMap {
+			templateMap[key] = value
+		}
+		overlay[name] = templateMap
+	}
+
+	// the texts of the overlay are parsed into a cache of the tenant, which is dropped with its overlay
+	cache := newConfigCache()
+	tenantTemplates := templateSet{overlay: overlay, templates: base.Templates, cache: cache}
+	if err := validateDefaultTemplate(tenantTemplates); err != nil {
+		return nil, err
+	}
+	if err := validateTemplates(overlayTemplates, tenantTemplates); err != nil {
+		return nil, err
+	}
+	// the texts of base templates are cached with base, which other tenants share
+	cache.base = base.cache
+
+	config := *base
+	config.templateOverlay = overlay
+	config.cache = cache
+
+	return &config, nil
+}
+
+// copyTemplateValue copies the maps and lists of a template value
+func copyTemplateValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		valueCopy := make(map[string]interface{}, len(typedValue))
+		for key, item := range typedValue {
+			valueCopy[key] = copyTemplateValue(item)
+		}
+		return valueCopy
+	case []interface{}:
+		valueCopy := make([]interface{}, len(typedValue))
+		for i, item := range typedValue {
+			valueCopy[i] = copyTemplateValue(item)
+		}
+		return valueCopy
+	default:
+		return value
+	}
+}
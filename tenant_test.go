@@ -0,0 +1,174 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestTenantTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantTestSuite))
+}
+
+type TenantTestSuite struct {
+	suite.Suite
+	Config   *Config
+	Registry *TenantRegistry
+	Address  *Address
+}
+
+func (suite *TenantTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = OneLine
+	suite.Registry = NewTenantRegistry(suite.Config)
+	suite.Address = &Address{Road: "Platz der Republik", HouseNumber: "1", Postcode: "11011", City: "Berlin", CountryCode: "DE"}
+}
+
+func (suite *TenantTestSuite) format(config *Config) string {
+	formattedAddress, err := FormatAddress(suite.Address, config)
+	suite.Require().NoError(err)
+
+	return formattedAddress.(string)
+}
+
+func (suite *TenantTestSuite) TestOverlay() {
+	suite.Require().NoError(suite.Registry.Register("acme", map[string]interface{}{
+		"DE": map[string]interface{}{"address_template": "{{{road}}} {{{house_number}}}\nD-{{{postcode}}} {{{city}}}\n"},
+	}))
+
+	config := suite.Registry.Config("acme")
+	suite.Equal("Platz der Republik 1, D-11011 Berlin", suite.format(config))
+	suite.Equal("Platz der Republik 1, 11011 Berlin", suite.format(suite.Config))
+	suite.Same(suite.Config, suite.Registry.Config("globex"))
+
+	// the keys of the base template are kept and the templates are not copied
+	template, _ := config.getTemplates().get("DE")
+	suite.Contains(template, "postformat_replace")
+	suite.Equal(reflect.ValueOf(suite.Config.Templates).Pointer(), reflect.ValueOf(config.Templates).Pointer())
+
+	suite.Equal([]string{"acme"}, suite.Registry.Tenants())
+	suite.Registry.Unregister("acme")
+	suite.Same(suite.Config, suite.Registry.Config("acme"))
+	suite.Empty(suite.Registry.Tenants())
+}
+
+func (suite *TenantTestSuite) TestOverlayReplacements() {
+	suite.Require().NoError(suite.Registry.Register("acme", map[string]interface{}{
+		"DE": map[string]interface{}{"replace": []interface{}{[]interface{}{"^Platz der ", "Pl. d. "}}},
+	}))
+
+	address, err := GetFixedAddress(map[string]string{"road": "Platz der Republik", "country_code": "DE"}, suite.Registry.Config("acme"))
+	suite.NoError(err)
+	suite.Equal("Pl. d. Republik", address.Road)
+}
+
+func (suite *TenantTestSuite) TestOverlayIsCopied() {
+	overlay := map[string]interface{}{
+		"DE": map[string]interface{}{"address_template": "{{{road}}} {{{house_number}}}\nD-{{{postcode}}} {{{city}}}\n"},
+	}
+	suite.Require().NoError(suite.Registry.Register("acme", overlay))
+	overlay["DE"].(map[string]interface{})["address_template"] = "{{{city}}}\n"
+	overlay["GB"] = "{{{road}}}\n"
+
+	suite.Equal("Platz der Republik 1, D-11011 Berlin", suite.format(suite.Registry.Config("acme")))
+	suite.Require().NoError(suite.Registry.SetBase(suite.Config))
+	suite.Equal("Platz der Republik 1, D-11011 Berlin", suite.format(suite.Registry.Config("acme")))
+}
+
+func (suite *TenantTestSuite) TestOverlayCache() {
+	overlayTemplate := "{{{road}}} {{{house_number}}}\nD-{{{postcode}}} {{{city}}}\n"
+	suite.Require().NoError(suite.Registry.Register("acme", map[string]interface{}{
+		"DE": map[string]interface{}{"address_template": overlayTemplate},
+	}))
+	config := suite.Registry.Config("acme")
+
+	suite.Address.CountryCode = "CH"
+	suite.format(config)
+	template, _ := suite.Config.getTemplates().get("CH")
+	baseTemplate := template.(map[string]interface{})["address_template"].(string)
+
+	// the templates of the base config are cached with the base config, the overlay with the tenant
+	_, isBaseCached := suite.Config.cache.templates.Load(baseTemplate)
+	suite.True(isBaseCached)
+	_, isTenantCached := config.cache.templates.Load(baseTemplate)
+	suite.False(isTenantCached)
+	_, isOverlayCached := suite.Config.cache.templates.Load(overlayTemplate)
+	suite.False(isOverlayCached)
+	_, isOverlayCached = config.cache.templates.Load(overlayTemplate)
+	suite.True(isOverlayCached)
+}
+
+func (suite *TenantTestSuite) TestInvalidOverlay() {
+	overlays := []map[string]interface{}{
+		{"DE": map[string]interface{}{"address_template": "{{#first}}"}},
+		{"DE": map[string]interface{}{"postformat_replace": []interface{}{[]interface{}{"(", ""}}}},
+		{"DE": map[string]interface{}{"use_country": "XX"}},
+		{"DE": 42},
+		{"default": "{{{road}}}"},
+		{"default": map[string]interface{}{"fallback_template": 42}},
+	}
+
+	for _, overlay := range overlays {
+		suite.Error(suite.Registry.Register("acme", overlay))
+	}
+	suite.Same(suite.Config, suite.Registry.Config("acme"))
+
+	suite.EqualError(suite.Registry.Register("t1", map[string]interface{}{"default": "{{{road}}}"}), "tenant t1: default template is missing")
+}
+
+func (suite *TenantTestSuite) TestSetBase() {
+	suite.Require().NoError(suite.Registry.Register("acme", map[string]interface{}{
+		"DE": map[string]interface{}{"address_template": "{{{road}}} {{{house_number}}}\nD-{{{postcode}}} {{{city}}}\n"},
+	}))
+	suite.Require().NoError(suite.Registry.Register("globex", map[string]interface{}{
+		"DE": map[string]interface{}{"use_country": "CH"},
+	}))
+
+	base := *suite.Config
+	base.Templates = make(map[string]template, len(suite.Config.Templates))
+	for name, template := range suite.Config.Templates {
+		base.Templates[name] = template
+	}
+	delete(base.Templates, "CH")
+	base.OutputFormat = PostalFormat
+
+	suite.EqualError(suite.Registry.SetBase(&base), "removed invalid overlays: tenant globex: template DE: use_country CH has no template")
+	suite.Equal([]string{"acme"}, suite.Registry.Tenants())
+	suite.Equal("Platz der Republik 1\nD-11011 Berlin\n", suite.format(suite.Registry.Config("acme")))
+
+	suite.Require().NoError(suite.Registry.Register("initech", map[string]interface{}{
+		"default": map[string]interface{}{"address_template": "{{{road}}} {{{house_number}}}\n{{{city}}}\n"},
+	}))
+	withoutFallback := base
+	withoutFallback.Templates = make(map[string]template, len(base.Templates))
+	for name, template := range base.Templates {
+		withoutFallback.Templates[name] = template
+	}
+	withoutFallback.Templates["default"] = map[string]interface{}{"address_template": "{{{road}}}\n"}
+
+	suite.EqualError(suite.Registry.SetBase(&withoutFallback), "removed invalid overlays: tenant acme: default template has no fallback_template; tenant initech: default template has no fallback_template")
+	suite.Empty(suite.Registry.Tenants())
+}
+
+func (suite *TenantTestSuite) TestConcurrentRegister() {
+	overlay := map[string]interface{}{"DE": map[string]interface{}{"address_template": "{{{road}}}\n"}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_, err := FormatAddress(suite.Address, suite.Registry.Config("acme"))
+				suite.NoError(err)
+			}
+		}()
+	}
+
+	for i := 0; i < 20; i++ {
+		suite.NoError(suite.Registry.Register("acme", overlay))
+		suite.Registry.Unregister("acme")
+	}
+	wg.Wait()
+}
@@ -0,0 +1,346 @@
+package addrFmt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Transliteration selects how WithTransliteration converts the components into the latin script. Han characters
+// (Chinese hanzi, Japanese kanji) are not supported as their reading depends on the language and the word
+type Transliteration int
+
+const (
+	TransliterateNone Transliteration = iota
+	// TransliterateLatin romanizes Cyrillic (ISO 9), Greek (ISO 843), Arabic and Hebrew (ALA-LC), Georgian, Armenian,
+	// Japanese kana (Hepburn) and Hangul (Revised Romanization). Han characters have no reading and are kept
+	TransliterateLatin Transliteration = iota
+	// TransliterateLatin1 romanizes and additionally folds characters that are not part of Latin-1, formatting fails
+	// with ErrNotTransliterable for characters that cannot be folded such as Han characters
+	TransliterateLatin1 Transliteration = iota
+	// TransliterateASCII romanizes and folds every character to ASCII, formatting fails with ErrNotTransliterable for
+	// characters that cannot be folded such as Han characters
+	TransliterateASCII Transliteration = iota
+)
+
+var ErrNotTransliterable = errors.New("character cannot be transliterated")
+
+// WithTransliteration converts every component into the latin script before rendering
+func WithTransliteration(transliteration Transliteration) Option {
+	return func(o *options) {
+		o.transliteration = transliteration
+	}
+}
+
+// transliterateComponents converts the component values, codes are kept as they are
+func transliterateComponents(addressMap addressMap, transliteration Transliteration) error {
+	for component, value := range addressMap {
+		if !strings.HasSuffix(component, "_code") {
+			transliterated, err := transliterate(value, transliteration)
+			if err != nil {
+				return err
+			}
+			addressMap[component] = transliterated
+		}
+	}
+
+	return nil
+}
+
+func transliterate(value string, transliteration Transliteration) (string, error) {
+	if transliteration == TransliterateNone {
+		return value, nil
+	}
+
+	value = romanize(value)
+
+	switch transliteration {
+	case TransliterateLatin1:
+		return foldDiacritics(value, func(r rune) bool { return r <= unicode.MaxLatin1 })
+	case TransliterateASCII:
+		return foldDiacritics(value, func(r rune) bool { return r <= unicode.MaxASCII })
+	default:
+		return value, nil
+	}
+}
+
+func romanize(value string) string {
+	runes := []rune(value)
+	var builder strings.Builder
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case isKana(r):
+			i += romanizeKana(runes[i:], &builder) - 1
+		case r >= hangulSyllableStart && r <= hangulSyllableEnd:
+			builder.WriteString(romanizeHangul(r))
+		case unicode.Is(unicode.Greek, r) && i+1 < len(runes) && greekDigraphs[string(unicode.ToLower(r))+string(unicode.ToLower(runes[i+1]))] != "":
+			digraph := greekDigraphs[string(unicode.ToLower(r))+string(unicode.ToLower(runes[i+1]))]
+			builder.WriteString(applyCase(digraph, runes, i))
+			i++
+		case unicode.Is(unicode.Arabic, r) && r == 'ا' && i+1 < len(runes) && runes[i+1] == 'ل' && (i == 0 || unicode.IsSpace(runes[i-1])):
+			// article al-
+			builder.WriteString("al-")
+			i++
+		default:
+			if romanized, hasRomanization := romanizations[unicode.ToLower(r)]; hasRomanization {
+				builder.WriteString(applyCase(romanized, runes, i))
+			} else {
+				builder.WriteRune(r)
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// applyCase transfers the case of runes[i] to its romanization, words in capitals stay in capitals
+func applyCase(romanized string, runes []rune, i int) string {
+	if !unicode.IsUpper(runes[i]) || romanized == "" {
+		return romanized
+	}
+
+	isCapitalWord := (i+1 < len(runes) && unicode.IsUpper(runes[i+1])) || (i > 0 && unicode.IsUpper(runes[i-1]))
+	if isCapitalWord {
+		return strings.ToUpper(romanized)
+	}
+
+	romanizedRunes := []rune(romanized)
+	romanizedRunes[0] = unicode.ToUpper(romanizedRunes[0])
+
+	return string(romanizedRunes)
+}
+
+// romanizeKana romanizes a run of kana with the modified Hepburn system and returns the number of consumed runes
+func romanizeKana(runes []rune, builder *strings.Builder) int {
+	i := 0
+	result := ""
+	doubleNextConsonant := false
+	isAfterN := false
+
+	for ; i < len(runes) && isKana(runes[i]); i++ {
+		r := toHiragana(runes[i])
+
+		var syllable string
+		if digraph, isDigraph := kanaDigraphs[string(r)+string(toHiragana(nextRune(runes, i)))]; isDigraph {
+			syllable = digraph
+			i++
+		} else if r == 'っ' {
+			doubleNextConsonant = true
+			continue
+		} else if r == 'ー' {
+			result = lengthenLastVowel(result)
+			continue
+		} else {
+			syllable = kana[r]
+		}
+
+		if syllable == "" {
+			continue
+		}
+
+		// sokuon doubles the following consonant, ch becomes tch
+		if doubleNextConsonant && strings.HasPrefix(syllable, "ch") {
+			syllable = "t" + syllable
+		} else if doubleNextConsonant && !strings.ContainsRune("aeiou", rune(syllable[0])) {
+			syllable = syllable[:1] + syllable
+		}
+		// syllabic n is separated from a following vowel or y
+		if isAfterN && strings.ContainsRune("aeiouy", rune(syllable[0])) {
+			result += "'"
+		}
+
+		result += syllable
+		doubleNextConsonant = false
+		isAfterN = r == 'ん'
+	}
+
+	builder.WriteString(result)
+
+	return i
+}
+
+func nextRune(runes []rune, i int) rune {
+	if i+1 < len(runes) {
+		return runes[i+1]
+	}
+
+	return 0
+}
+
+// the prolonged sound mark belongs to the common script
+func isKana(r rune) bool {
+	return unicode.In(r, unicode.Hiragana, unicode.Katakana) || r == 'ー'
+}
+
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - ('ァ' - 'ぁ')
+	}
+
+	return r
+}
+
+var macrons = map[byte]string{'a': "ā", 'e': "ē", 'i': "ī", 'o': "ō", 'u': "ū"}
+
+func lengthenLastVowel(result string) string {
+	if result == "" {
+		return result
+	}
+
+	if macron, isVowel := macrons[result[len(result)-1]]; isVowel {
+		return result[:len(result)-1] + macron
+	}
+
+	return result
+}
+
+const hangulSyllableStart = 0xAC00
+const hangulSyllableEnd = 0xD7A3
+
+var hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+var hangulMedials = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+var hangulFinals = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+
+// romanizeHangul decomposes a syllable into its jamo and romanizes them with the Revised Romanization of Korean
+func romanizeHangul(r rune) string {
+	index := int(r - hangulSyllableStart)
+
+	return hangulInitials[index/(21*28)] + hangulMedials[(index%(21*28))/28] + hangulFinals[index%28]
+}
+
+// foldDiacritics replaces characters that are not kept by their base letters or an equivalent of kept characters and
+// drops combining marks of latin letters. Other characters return ErrNotTransliterable
+func foldDiacritics(value string, isKept func(r rune) bool) (string, error) {
+	var builder strings.Builder
+	isAfterLatinLetter := false
+
+	for _, r := range value {
+		if unicode.Is(unicode.Mn, r) && !isKept(r) {
+			// the marks of other scripts, e.g. Thai or Devanagari vowel signs, are part of their letters
+			if !isAfterLatinLetter {
+				return "", fmt.Errorf("%w: %q in %q", ErrNotTransliterable, r, value)
+			}
+			continue
+		}
+		isAfterLatinLetter = unicode.Is(unicode.Latin, r)
+
+		lower := unicode.ToLower(r)
+		switch folded, hasFolding := diacriticFoldings[lower]; {
+		case isKept(r):
+			builder.WriteRune(r)
+		case hasFolding && unicode.IsUpper(r):
+			builder.WriteString(strings.ToUpper(folded[:1]) + folded[1:])
+		case hasFolding:
+			builder.WriteString(folded)
+		case isKept(lower) && unicode.IsUpper(r):
+			// e.g. the dotted capital I, whose lowercase letter is i
+			builder.WriteRune(unicode.ToUpper(lower))
+		case unicode.IsSpace(r):
+			builder.WriteByte(' ')
+		case unicode.Is(unicode.Cf, r):
+			// format characters such as zero width spaces and soft hyphens
+			continue
+		default:
+			return "", fmt.Errorf("%w: %q in %q", ErrNotTransliterable, r, value)
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// diacriticFoldings maps lowercase latin letters with diacritics to their base letters
+var diacriticFoldings = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g", 'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ĩ': "i", 'ī': "i", 'ĭ': "i", 'į': "i", 'ı': "i",
+	'ĵ': "j", 'ķ': "k", 'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ŀ': "l", 'ł': "l",
+	'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ŏ': "o", 'ő': "o", 'œ': "oe",
+	'ŕ': "r", 'ŗ': "r", 'ř': "r", 'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ș': "s", 'ß': "ss",
+	'ţ': "t", 'ť': "t", 'ŧ': "t", 'ț': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ũ': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ŵ': "w", 'ý': "y", 'ÿ': "y", 'ŷ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+	'ǎ': "a", 'ǐ': "i", 'ǒ': "o", 'ǔ': "u", 'ǵ': "g", 'ḱ': "k", 'ẑ': "z", 'ǰ': "j", 'ḥ': "h", 'ṣ': "s", 'ḍ': "d",
+	'ṭ': "t", 'ẓ': "z", 'ơ': "o", 'ư': "u", 'ạ': "a", 'ả': "a", 'ấ': "a", 'ầ': "a", 'ẩ': "a", 'ẫ': "a", 'ậ': "a",
+	'ắ': "a", 'ằ': "a", 'ẳ': "a", 'ẵ': "a", 'ặ': "a", 'ẹ': "e", 'ẻ': "e", 'ẽ': "e", 'ế': "e", 'ề': "e", 'ể': "e",
+	'ễ': "e", 'ệ': "e", 'ỉ': "i", 'ị': "i", 'ọ': "o", 'ỏ': "o", 'ố': "o", 'ồ': "o", 'ổ': "o", 'ỗ': "o", 'ộ': "o",
+	'ớ': "o", 'ờ': "o", 'ở': "o", 'ỡ': "o", 'ợ': "o", 'ụ': "u", 'ủ': "u", 'ứ': "u", 'ừ': "u", 'ử': "u", 'ữ': "u",
+	'ự': "u", 'ỳ': "y", 'ỵ': "y", 'ỷ': "y", 'ỹ': "y",
+	'ʹ': "'", 'ʺ': "\"", 'ʻ': "'", 'ʼ': "'", '‘': "'", '’': "'", '‚': "'", '‹': "'", '›': "'",
+	'«': "\"", '»': "\"", '„': "\"", '“': "\"", '”': "\"",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '…': "...", '·': ".", '•': "-",
+	'€': "EUR", '£': "GBP", '¥': "JPY", '¢': "c", '№': "No", '°': "o", 'ª': "a", 'º': "o",
+	'¹': "1", '²': "2", '³': "3", '¼': "1/4", '½': "1/2", '¾': "3/4", '×': "x", '÷': "/", '¡': "!", '¿': "?",
+	'¦': "|", '´': "'", '`': "'", '¨': "\"", '¯': "-", '¸': ",",
+}
+
+// romanizations maps lowercase letters of non-latin scripts to latin letters
+var romanizations = map[rune]string{
+	// Cyrillic (ISO 9)
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë", 'ж': "ž", 'з': "z", 'и': "i", 'й': "j",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "h", 'ц': "c", 'ч': "č", 'ш': "š", 'щ': "ŝ", 'ъ': "ʺ", 'ы': "y", 'ь': "ʹ", 'э': "è", 'ю': "û", 'я': "â",
+	'є': "ê", 'і': "ì", 'ї': "ï", 'ґ': "g̀", 'ў': "ŭ", 'ђ': "đ", 'ћ': "ć", 'џ': "d̂", 'љ': "l̂", 'њ': "n̂", 'ј': "ǰ",
+	'ѕ': "ẑ", 'ќ': "ḱ", 'ѓ': "ǵ",
+	// Greek (ISO 843)
+	'α': "a", 'ά': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'έ': "e", 'ζ': "z", 'η': "i", 'ή': "i", 'θ': "th",
+	'ι': "i", 'ί': "i", 'ϊ': "i", 'ΐ': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'ό': "o",
+	'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'ύ': "y", 'ϋ': "y", 'ΰ': "y", 'φ': "f", 'χ': "ch",
+	'ψ': "ps", 'ω': "o", 'ώ': "o",
+	// Arabic (ALA-LC without short vowels)
+	'ا': "ā", 'أ': "a", 'إ': "i", 'آ': "ā", 'ب': "b", 'ت': "t", 'ث': "th", 'ج': "j", 'ح': "ḥ", 'خ': "kh", 'د': "d",
+	'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s", 'ش': "sh", 'ص': "ṣ", 'ض': "ḍ", 'ط': "ṭ", 'ظ': "ẓ", 'ع': "ʻ", 'غ': "gh",
+	'ف': "f", 'ق': "q", 'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y", 'ى': "á", 'ة': "h",
+	'ء': "ʼ", 'ئ': "ʼ", 'ؤ': "ʼ", 'پ': "p", 'چ': "ch", 'ژ': "zh", 'گ': "g", 'ک': "k", 'ی': "y",
+	'َ': "a", 'ُ': "u", 'ِ': "i", 'ْ': "", 'ّ': "", '،': ",",
+	// Hebrew (ALA-LC without vowels)
+	'א': "", 'ב': "b", 'ג': "g", 'ד': "d", 'ה': "h", 'ו': "v", 'ז': "z", 'ח': "ḥ", 'ט': "ṭ", 'י': "y", 'כ': "kh",
+	'ך': "kh", 'ל': "l", 'מ': "m", 'ם': "m", 'נ': "n", 'ן': "n", 'ס': "s", 'ע': "ʻ", 'פ': "f", 'ף': "f", 'צ': "ts",
+	'ץ': "ts", 'ק': "k", 'ר': "r", 'ש': "sh", 'ת': "t",
+	// Georgian (national system)
+	'ა': "a", 'ბ': "b", 'გ': "g", 'დ': "d", 'ე': "e", 'ვ': "v", 'ზ': "z", 'თ': "t", 'ი': "i", 'კ': "k'", 'ლ': "l",
+	'მ': "m", 'ნ': "n", 'ო': "o", 'პ': "p'", 'ჟ': "zh", 'რ': "r", 'ს': "s", 'ტ': "t'", 'უ': "u", 'ფ': "p", 'ქ': "k",
+	'ღ': "gh", 'ყ': "q'", 'შ': "sh", 'ჩ': "ch", 'ც': "ts", 'ძ': "dz", 'წ': "ts'", 'ჭ': "ch'", 'ხ': "kh", 'ჯ': "j",
+	'ჰ': "h",
+	// Armenian (ISO 9985)
+	'ա': "a", 'բ': "b", 'գ': "g", 'դ': "d", 'ե': "e", 'զ': "z", 'է': "ē", 'ը': "ë", 'թ': "t'", 'ժ': "ž", 'ի': "i",
+	'լ': "l", 'խ': "x", 'ծ': "ç", 'կ': "k", 'հ': "h", 'ձ': "j", 'ղ': "ł", 'ճ': "č", 'մ': "m", 'յ': "y", 'ն': "n",
+	'շ': "š", 'ո': "o", 'չ': "č'", 'պ': "p", 'ջ': "ǰ", 'ռ': "ṙ", 'ս': "s", 'վ': "v", 'տ': "t", 'ր': "r", 'ց': "c'",
+	'ւ': "w", 'փ': "p'", 'ք': "k'", 'օ': "ō", 'ֆ': "f", 'և': "ew",
+}
+
+// greekDigraphs are romanized together following ISO 843
+var greekDigraphs = map[string]string{
+	"ου": "ou", "ού": "ou", "αυ": "av", "αύ": "av", "ευ": "ev", "εύ": "ev", "γγ": "ng", "γξ": "nx", "γχ": "nch",
+}
+
+// kana maps hiragana to their modified Hepburn romanization, katakana are converted to hiragana before
+var kana = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o", 'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so", 'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te",
+	'と': "to", 'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no", 'は': "ha", 'ひ': "hi", 'ふ': "fu",
+	'へ': "he", 'ほ': "ho", 'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo", 'や': "ya", 'ゆ': "yu",
+	'よ': "yo", 'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro", 'わ': "wa", 'ゐ': "i", 'ゑ': "e",
+	'を': "o", 'ん': "n", 'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go", 'ざ': "za", 'じ': "ji",
+	'ず': "zu", 'ぜ': "ze", 'ぞ': "zo", 'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do", 'ば': "ba",
+	'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo", 'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o", 'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゔ': "vu", 'ゎ': "wa",
+	'ヷ': "va", 'ヸ': "vi", 'ヹ': "ve", 'ヺ': "vo",
+}
+
+// kanaDigraphs are two kana romanized together such as きゃ => kya
+var kanaDigraphs = map[string]string{
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo", "しゃ": "sha", "しゅ": "shu", "しょ": "sho", "ちゃ": "cha", "ちゅ": "chu",
+	"ちょ": "cho", "にゃ": "nya", "にゅ": "nyu", "にょ": "nyo", "ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo", "みゃ": "mya",
+	"みゅ": "myu", "みょ": "myo", "りゃ": "rya", "りゅ": "ryu", "りょ": "ryo", "ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"じゃ": "ja", "じゅ": "ju", "じょ": "jo", "ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo", "びゃ": "bya", "びゅ": "byu",
+	"びょ": "byo", "ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo", "ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo",
+	"てぃ": "ti", "でぃ": "di", "しぇ": "she", "じぇ": "je", "ちぇ": "che", "うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+	"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo",
+}
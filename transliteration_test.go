@@ -0,0 +1,155 @@
+package addrFmt
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"unicode"
+)
+
+func TestTransliterationTestSuite(t *testing.T) {
+	suite.Run(t, new(TransliterationTestSuite))
+}
+
+type TransliterationTestSuite struct {
+	suite.Suite
+	Config *Config
+}
+
+func (suite *TransliterationTestSuite) SetupTest() {
+	suite.Config = readTestConfig()
+	suite.Config.OutputFormat = OneLine
+}
+
+func (suite *TransliterationTestSuite) TestTransliterate() {
+	transliterations := map[string][3]string{
+		// Latin, Latin-1, ASCII
+		"Москва":                  {"Moskva", "Moskva", "Moskva"},
+		"улица Жуковского":        {"ulica Žukovskogo", "ulica Zukovskogo", "ulica Zukovskogo"},
+		"Київ":                    {"Kiïv", "Kiïv", "Kiiv"},
+		"Θεσσαλονίκη":             {"Thessaloniki", "Thessaloniki", "Thessaloniki"},
+		"ΑΘΗΝΑ":                   {"ATHINA", "ATHINA", "ATHINA"},
+		"Ευρώπη":                  {"Evropi", "Evropi", "Evropi"},
+		"القاهرة":                 {"al-qāhrh", "al-qahrh", "al-qahrh"},
+		"しんじゅく":                   {"shinjuku", "shinjuku", "shinjuku"},
+		"ホッカイドウ":                  {"hokkaidou", "hokkaidou", "hokkaidou"},
+		"トーキョー":                   {"tōkyō", "tokyo", "tokyo"},
+		"きんえん":                    {"kin'en", "kin'en", "kin'en"},
+		"서울특별시":                   {"seoulteukbyeolsi", "seoulteukbyeolsi", "seoulteukbyeolsi"},
+		"თბილისი":                 {"tbilisi", "tbilisi", "tbilisi"},
+		"Straße Æbeltoft":         {"Straße Æbeltoft", "Straße Æbeltoft", "Strasse Aebeltoft"},
+		"Łódź":                    {"Łódź", "Lódz", "Lodz"},
+		"İstanbul":                {"İstanbul", "Istanbul", "Istanbul"},
+		"€ 5 — “x”":               {"€ 5 — “x”", "EUR 5 - \"x\"", "EUR 5 - \"x\""},
+		"Nº 3½ ¿Dónde?":           {"Nº 3½ ¿Dónde?", "Nº 3½ ¿Dónde?", "No 31/2 ?Donde?"},
+		"Sankt\u00a0Gallen\u200b": {"Sankt\u00a0Gallen\u200b", "Sankt\u00a0Gallen", "Sankt Gallen"},
+	}
+
+	for value, expected := range transliterations {
+		suite.Equal(expected[0], suite.transliterate(value, TransliterateLatin), "Latin of %s", value)
+		suite.Equal(expected[1], suite.transliterate(value, TransliterateLatin1), "Latin-1 of %s", value)
+		suite.Equal(expected[2], suite.transliterate(value, TransliterateASCII), "ASCII of %s", value)
+	}
+
+	suite.Equal("Москва", suite.transliterate("Москва", TransliterateNone))
+}
+
+func (suite *TransliterationTestSuite) transliterate(value string, transliteration Transliteration) string {
+	transliterated, err := transliterate(value, transliteration)
+	suite.Require().NoError(err, value)
+
+	switch transliteration {
+	case TransliterateLatin1:
+		suite.True(isInRange(transliterated, unicode.MaxLatin1), "%q is not Latin-1", transliterated)
+	case TransliterateASCII:
+		suite.True(isInRange(transliterated, unicode.MaxASCII), "%q is not ASCII", transliterated)
+	}
+
+	return transliterated
+}
+
+func isInRange(value string, max rune) bool {
+	for _, r := range value {
+		if r > max {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (suite *TransliterationTestSuite) TestNotTransliterable() {
+	// the vowel signs of Thai and Devanagari must not be dropped
+	for _, value := range []string{"ภูเก็ต", "दिल्ली"} {
+		transliterated, err := transliterate(value, TransliterateLatin)
+		suite.NoError(err)
+		suite.Equal(value, transliterated)
+
+		_, err = transliterate(value, TransliterateLatin1)
+		suite.ErrorIs(err, ErrNotTransliterable, value)
+		_, err = transliterate(value, TransliterateASCII)
+		suite.ErrorIs(err, ErrNotTransliterable, value)
+	}
+}
+
+func (suite *TransliterationTestSuite) TestHanIsNotSupported() {
+	for _, value := range []string{"東京都", "北京市"} {
+		transliterated, err := transliterate(value, TransliterateLatin)
+		suite.NoError(err)
+		suite.Equal(value, transliterated)
+
+		_, err = transliterate(value, TransliterateLatin1)
+		suite.ErrorIs(err, ErrNotTransliterable, value)
+		_, err = transliterate(value, TransliterateASCII)
+		suite.ErrorIs(err, ErrNotTransliterable, value)
+	}
+
+	// kana next to Han characters are romanized
+	suite.Equal("新宿 eki", suite.transliterate("新宿 えき", TransliterateLatin))
+
+	address := &Address{Road: "长安街", City: "北京市", CountryCode: "CN"}
+	_, err := FormatAddress(address, suite.Config, WithTransliteration(TransliterateASCII))
+	suite.ErrorIs(err, ErrNotTransliterable)
+}
+
+func (suite *TransliterationTestSuite) TestFormatAddressTransliterated() {
+	address := &Address{
+		Road:        "Тверская улица",
+		HouseNumber: "13",
+		Postcode:    "125032",
+		City:        "Москва",
+		Country:     "Россия",
+		CountryCode: "RU",
+	}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithTransliteration(TransliterateASCII))
+
+	suite.NoError(err)
+	suite.Equal("Tverskaa ulica 13, 125032 Moskva, Rossia", formattedAddress)
+
+	formattedAddress, err = FormatForMailing(address, "DE", suite.Config, WithTransliteration(TransliterateASCII), WithUppercase(UppercaseLastLines))
+
+	suite.NoError(err)
+	suite.Equal("Tverskaa ulica 13, 125032 MOSKVA, RUSSLAND", formattedAddress)
+}
+
+func (suite *TransliterationTestSuite) TestUppercase() {
+	suite.Equal("STRASSE", toUpper("Straße", "de"))
+	suite.Equal("İSTANBUL", toUpper("istanbul", "tr"))
+	suite.Equal("ISTANBUL", toUpper("istanbul", "en"))
+	suite.Equal("ΑΘΗΝΑ", toUpper("Αθήνα", "el"))
+
+	address := &Address{Road: "Hauptstraße", HouseNumber: "1", Postcode: "10115", City: "Berlin", CountryCode: "DE"}
+
+	formattedAddress, err := FormatAddress(address, suite.Config, WithUppercase(UppercaseAll))
+
+	suite.NoError(err)
+	suite.Equal("HAUPTSTRASSE 1, 10115 BERLIN", formattedAddress)
+
+	address = &Address{Road: "İstiklal Caddesi", HouseNumber: "1", Postcode: "34433", City: "istanbul", CountryCode: "TR"}
+
+	formattedAddress, err = FormatAddress(address, suite.Config, WithUppercase(UppercaseAll), WithLanguage("tr"), WithTransliteration(TransliterateASCII))
+
+	suite.NoError(err)
+	suite.True(isInRange(formattedAddress.(string), unicode.MaxASCII), "%q is not ASCII", formattedAddress)
+	suite.Contains(formattedAddress, "ISTANBUL")
+}
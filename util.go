@@ -1,58 +1,68 @@
 package addrFmt
 
 import (
-	"errors"
-	"reflect"
+	"github.com/cbroglie/mustache"
+	"regexp"
 	"sort"
 	"strings"
+	"sync"
 )
 
-var addressMemberNameMapping = map[string]string{
-	"Attention":     "attention",
-	"HouseNumber":   "house_number",
-	"House":         "house",
-	"Road":          "road",
-	"Village":       "village",
-	"Suburb":        "suburb",
-	"City":          "city",
-	"County":        "county",
-	"CountyCode":    "county_code",
-	"Postcode":      "postcode",
-	"StateDistrict": "state_district",
-	"State":         "state",
-	"StateCode":     "state_code",
-	"Region":        "region",
-	"Island":        "island",
-	"Country":       "country",
-	"CountryCode":   "country_code",
-	"Continent":     "continent",
-	"Archipelago":   "archipelago",
-	"Municipality":  "municipality",
-	"Town":          "town",
-	"Hamlet":        "hamlet",
-	"PostalCity":    "postal_city",
-	"Neighbourhood": "neighbourhood",
-	"Quarter":       "quarter",
-	"Residential":   "residential",
-	"CityDistrict":  "city_district",
-} // (struct Name, template Name)
+type addressField struct {
+	component string
+	value     func(address *Address) *string
+}
+
+// addressFields maps the fields of Address to the component names used in OpenCageData templates in the order of Address
+var addressFields = []addressField{
+	{component: "attention", value: func(address *Address) *string { return &address.Attention }},
+	{component: "house", value: func(address *Address) *string { return &address.House }},
+	{component: "house_number", value: func(address *Address) *string { return &address.HouseNumber }},
+	{component: "road", value: func(address *Address) *string { return &address.Road }},
+	{component: "hamlet", value: func(address *Address) *string { return &address.Hamlet }},
+	{component: "village", value: func(address *Address) *string { return &address.Village }},
+	{component: "neighbourhood", value: func(address *Address) *string { return &address.Neighbourhood }},
+	{component: "postal_city", value: func(address *Address) *string { return &address.PostalCity }},
+	{component: "city", value: func(address *Address) *string { return &address.City }},
+	{component: "city_district", value: func(address *Address) *string { return &address.CityDistrict }},
+	{component: "municipality", value: func(address *Address) *string { return &address.Municipality }},
+	{component: "county", value: func(address *Address) *string { return &address.County }},
+	{component: "county_code", value: func(address *Address) *string { return &address.CountyCode }},
+	{component: "state_district", value: func(address *Address) *string { return &address.StateDistrict }},
+	{component: "postcode", value: func(address *Address) *string { return &address.Postcode }},
+	{component: "state", value: func(address *Address) *string { return &address.State }},
+	{component: "state_code", value: func(address *Address) *string { return &address.StateCode }},
+	{component: "region", value: func(address *Address) *string { return &address.Region }},
+	{component: "suburb", value: func(address *Address) *string { return &address.Suburb }},
+	{component: "quarter", value: func(address *Address) *string { return &address.Quarter }},
+	{component: "residential", value: func(address *Address) *string { return &address.Residential }},
+	{component: "town", value: func(address *Address) *string { return &address.Town }},
+	{component: "island", value: func(address *Address) *string { return &address.Island }},
+	{component: "archipelago", value: func(address *Address) *string { return &address.Archipelago }},
+	{component: "country", value: func(address *Address) *string { return &address.Country }},
+	{component: "country_code", value: func(address *Address) *string { return &address.CountryCode }},
+	{component: "continent", value: func(address *Address) *string { return &address.Continent }},
+}
+
+// component name => index in addressFields
+var addressFieldIndex = make(map[string]int, len(addressFields))
+
+func init() {
+	for i, field := range addressFields {
+		addressFieldIndex[field.component] = i
+	}
+}
 
 // convert Address to a map of names used in OpenCageData templates and their value
 func addressToMap(address *Address) (addressMap, error) {
-	v := reflect.ValueOf(*address)
-	addressMap := make(map[string]string, v.NumField())
-	addressType := v.Type()
-
-	for i := 0; i < v.NumField(); i++ {
-		if !v.Field(i).IsZero() {
-			fi := addressType.Field(i)
-
-			mapFieldName, hasMapping := addressMemberNameMapping[fi.Name]
-			if hasMapping {
-				addressMap[mapFieldName] = v.Field(i).String()
-			} else {
-				return nil, errors.New(fi.Name + " has no corresponding Name")
-			}
+	if address == nil {
+		return nil, ErrNilAddress
+	}
+
+	addressMap := make(map[string]string, len(addressFields))
+	for _, field := range addressFields {
+		if value := *field.value(address); value != "" {
+			addressMap[field.component] = value
 		}
 	}
 
@@ -60,23 +70,23 @@ func addressToMap(address *Address) (addressMap, error) {
 }
 
 // MapToAddress Convert map of address components used in OpenCageData templates and their aliases into an Address struct
+// MapToAddress does not modify addressMap
 func MapToAddress(addressMap map[string]string, componentAliases map[string]componentAlias, unknownAsAttention bool) *Address {
+	return mapToAddress(copyAddressMap(addressMap), componentAliases, unknownAsAttention)
+}
+
+// mapToAddress adds the alias values to addressMap
+func mapToAddress(addressMap addressMap, componentAliases map[string]componentAlias, unknownAsAttention bool) *Address {
 	// replace common aliases with their main keys used in templates
 	addressMap = applyComponentAliases(addressMap, componentAliases)
 
-	// invert addressMemberNameMapping to map component names to Address struct fields
-	componentNameAddressFieldMapping := getNameAddressFieldMapping()
-
 	var address Address
-	av := reflect.ValueOf(&address).Elem()
 
 	unknownFieldValues := make([]string, 0)
 
 	for k, v := range addressMap {
-		name, hasCorrespondingField := componentNameAddressFieldMapping[k]
-
-		if hasCorrespondingField {
-			av.FieldByName(name).Set(reflect.ValueOf(v))
+		if i, hasCorrespondingField := addressFieldIndex[k]; hasCorrespondingField {
+			*addressFields[i].value(&address) = v
 		} else // has no corresponding field and is also not an alias => attention
 		if _, hasAlias := componentAliases[k]; unknownAsAttention && !hasAlias {
 			unknownFieldValues = append(unknownFieldValues, v)
@@ -110,21 +120,99 @@ func applyComponentAliases(addressMap addressMap, componentAliases map[string]co
 	return addressMap
 }
 
-func getNameAddressFieldMapping() map[string]string {
-	componentNameAddressFieldMapping := make(map[string]string, len(addressMemberNameMapping))
-	for k, v := range addressMemberNameMapping {
-		componentNameAddressFieldMapping[v] = k
+func copyAddressMap(components addressMap) addressMap {
+	addressMapCopy := make(addressMap, len(components))
+	for k, v := range components {
+		addressMapCopy[k] = v
 	}
 
-	return componentNameAddressFieldMapping
+	return addressMapCopy
 }
 
-func findTemplate(countryCode string, templates map[string]template) template {
-	template, hasTemplate := templates[countryCode]
+type compiledPattern struct {
+	pattern *regexp.Regexp
+	err     error
+}
+
+// configCache holds what is derived from the templates of a config, so its size is bound by the config
+type configCache struct {
+	// template text => *mustache.Template
+	templates sync.Map
+	// replacement pattern => compiledPattern
+	patterns sync.Map
+	// base is the cache of the base config of a tenant, texts that are not in the cache of the tenant are cached there
+	base *configCache
+}
+
+func newConfigCache() *configCache {
+	return &configCache{}
+}
+
+// parseTemplate parses a template text of the config once, a nil cache parses it on every call
+func (c *configCache) parseTemplate(templateText string) (*mustache.Template, error) {
+	if c == nil {
+		return mustache.ParseString(templateText)
+	}
+	if parsedTemplate, isParsed := c.templates.Load(templateText); isParsed {
+		return parsedTemplate.(*mustache.Template), nil
+	}
+	if c.base != nil {
+		return c.base.parseTemplate(templateText)
+	}
+
+	parsedTemplate, err := mustache.ParseString(templateText)
+	if err != nil {
+		return nil, err
+	}
+	c.templates.Store(templateText, parsedTemplate)
+
+	return parsedTemplate, nil
+}
+
+// compilePattern compiles a pattern of the config once, it must not be used for patterns built from address
+// components. A nil cache compiles it on every call
+func (c *configCache) compilePattern(pattern string) (*regexp.Regexp, error) {
+	if c == nil {
+		return regexp.Compile(pattern)
+	}
+	if compiled, isCompiled := c.patterns.Load(pattern); isCompiled {
+		return compiled.(compiledPattern).pattern, compiled.(compiledPattern).err
+	}
+	if c.base != nil {
+		return c.base.compilePattern(pattern)
+	}
+
+	r, err := regexp.Compile(pattern)
+	c.patterns.Store(pattern, compiledPattern{pattern: r, err: err})
+
+	return r, err
+}
+
+func findTemplate(countryCode string, templates templateSet) template {
+	template, hasTemplate := templates.get(countryCode)
 
 	if hasTemplate {
 		return template
 	}
 
-	return templates["default"]
+	template, _ = templates.get("default")
+
+	return template
+}
+
+// templateSet looks up templates in the overlay of a tenant first and in the templates of the config second
+type templateSet struct {
+	overlay   map[string]template
+	templates map[string]template
+	cache     *configCache
+}
+
+func (t templateSet) get(name string) (template, bool) {
+	if template, hasTemplate := t.overlay[name]; hasTemplate {
+		return template, true
+	}
+
+	template, hasTemplate := t.templates[name]
+
+	return template, hasTemplate
 }